@@ -14,24 +14,36 @@ import (
 type AddTemplateCommand struct {
 	BaseCommand
 	currencycmds.OperationFlags
-	Sender         currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
-	Contract       currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
-	TemplateID     string                      `arg:"" name:"template-id" help:"template id" required:"true"`
-	TemplateName   string                      `arg:"" name:"template-name" help:"template name"  required:"true"`
-	ServiceDate    string                      `arg:"" name:"service-date" help:"service date; yyyy-MM-dd" required:"true"`
-	ExpirationDate string                      `arg:"" name:"expiration-date" help:"expiration date; yyyy-MM-dd" required:"true"`
-	TemplateShare  bool                        `name:"template-share" help:"template share; true | false" required:"true"`
-	MultiAudit     bool                        `name:"multi-audit" help:"multi audit; true | false" required:"true"`
-	DisplayName    string                      `arg:"" name:"display-name" help:"display name" required:"true"`
-	SubjectKey     string                      `arg:"" name:"subject-key" help:"subject key" required:"true"`
-	Description    string                      `arg:"" name:"description" help:"description"  required:"true"`
-	Creator        currencycmds.AddressFlag    `arg:"" name:"creator" help:"creator address"  required:"true"`
-	Currency       currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	sender         base.Address
-	contract       base.Address
-	serviceDate    types.Date
-	expiration     types.Date
-	creator        base.Address
+	Sender           currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract         currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	TemplateID       string                      `arg:"" name:"template-id" help:"template id" required:"true"`
+	TemplateName     string                      `arg:"" name:"template-name" help:"template name"  required:"true"`
+	ServiceDate      string                      `arg:"" name:"service-date" help:"service date; yyyy-MM-dd" required:"true"`
+	ExpirationDate   string                      `arg:"" name:"expiration-date" help:"expiration date; yyyy-MM-dd" required:"true"`
+	TemplateShare    bool                        `name:"template-share" help:"template share; true | false" required:"true"`
+	MultiAudit       bool                        `name:"multi-audit" help:"multi audit; true | false" required:"true"`
+	DisplayName      string                      `arg:"" name:"display-name" help:"display name" required:"true"`
+	SubjectKey       string                      `arg:"" name:"subject-key" help:"subject key" required:"true"`
+	Description      string                      `arg:"" name:"description" help:"description"  required:"true"`
+	Creator          currencycmds.AddressFlag    `arg:"" name:"creator" help:"creator address"  required:"true"`
+	Currency         currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Schema           string                      `name:"schema" help:"json schema of credential value" optional:""`
+	SchemaHash       string                      `name:"schema-hash" help:"sha256 hex of json schema" optional:""`
+	Auditors         []currencycmds.AddressFlag  `name:"auditor" help:"auditor address of multi audit template" optional:""`
+	AuditThreshold   uint64                      `name:"audit-threshold" help:"number of auditor approvals to activate credential" optional:""`
+	OfferLifetime    uint64                      `name:"offer-lifetime" help:"blocks in which holder accepts credential offer; holder consent required if set" optional:""`
+	Prerequisites    []string                    `name:"prerequisite" help:"template id of which holder should have active credential covering valid-from ~ valid-until in unix seconds" optional:""`
+	Cascade          bool                        `name:"cascade" help:"revoke credential together when prerequisite credential is revoked" optional:""`
+	MaxPerHolder     uint64                      `name:"max-per-holder" help:"maximum number of credentials held by holder; unlimited if not set" optional:""`
+	UniqueValue      bool                        `name:"unique-value" help:"credential value held by at most one holder" optional:""`
+	ServiceHeight    uint64                      `name:"service-height" help:"block height from which template issues credentials" optional:""`
+	ExpirationHeight uint64                      `name:"expiration-height" help:"last block height in which template issues credentials" optional:""`
+	sender           base.Address
+	contract         base.Address
+	serviceDate      types.Date
+	expiration       types.Date
+	creator          base.Address
+	auditors         []base.Address
 }
 
 func (cmd *AddTemplateCommand) Run(pctx context.Context) error { // nolint:dupl
@@ -86,6 +98,20 @@ func (cmd *AddTemplateCommand) parseFlags() error {
 	cmd.serviceDate = serviceDate
 	cmd.expiration = expiration
 
+	if err := types.IsValidTemplateSchema(cmd.Schema, cmd.SchemaHash); err != nil {
+		return errors.Wrap(err, "invalid schema")
+	}
+
+	auditors := make([]base.Address, len(cmd.Auditors))
+	for i := range cmd.Auditors {
+		auditor, err := cmd.Auditors[i].Encode(cmd.Encoders.JSON())
+		if err != nil {
+			return errors.Wrapf(err, "invalid auditor format, %q", cmd.Auditors[i].String())
+		}
+		auditors[i] = auditor
+	}
+	cmd.auditors = auditors
+
 	return nil
 }
 
@@ -106,6 +132,17 @@ func (cmd *AddTemplateCommand) createOperation() (base.Operation, error) { // no
 		cmd.SubjectKey,
 		cmd.Description,
 		cmd.creator,
+		cmd.Schema,
+		cmd.SchemaHash,
+		cmd.auditors,
+		cmd.AuditThreshold,
+		cmd.OfferLifetime,
+		cmd.Prerequisites,
+		types.Bool(cmd.Cascade),
+		cmd.MaxPerHolder,
+		types.Bool(cmd.UniqueValue),
+		base.Height(cmd.ServiceHeight),
+		base.Height(cmd.ExpirationHeight),
 		cmd.Currency.CID,
 	)
 
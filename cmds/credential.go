@@ -1,8 +1,33 @@
 package cmds
 
 type CredentialCommand struct {
-	RegisterModel RegisterModelCommand     `cmd:"" name:"register-model" help:"register credential service to contract account"`
-	AddTemplate   AddTemplateCommand       `cmd:"" name:"add-template" help:"add template to credential service"`
-	Issue         IssueCommand             `cmd:"" name:"issue" help:"issue credential"`
-	Revoke        RevokeCredentialsCommand `cmd:"" name:"revoke" help:"revoke credential"`
+	RegisterModel            RegisterModelCommand            `cmd:"" name:"register-model" help:"register credential service to contract account"`
+	AddTemplate              AddTemplateCommand              `cmd:"" name:"add-template" help:"add template to credential service"`
+	UpdateTemplate           UpdateTemplateCommand           `cmd:"" name:"update-template" help:"update mutable fields of template"`
+	DeprecateTemplate        DeprecateTemplateCommand        `cmd:"" name:"deprecate-template" help:"deprecate template; no more issuance"`
+	ShareTemplate            ShareTemplateCommand            `cmd:"" name:"share-template" help:"set contract accounts allowed to issue credential of shared template"`
+	PauseService             PauseServiceCommand             `cmd:"" name:"pause-service" help:"pause issuance of credential service"`
+	ResumeService            ResumeServiceCommand            `cmd:"" name:"resume-service" help:"resume issuance of paused credential service"`
+	CloseService             CloseServiceCommand             `cmd:"" name:"close-service" help:"close credential service permanently"`
+	IndexCredentials         IndexCredentialsCommand         `cmd:"" name:"index-credentials" help:"index credentials issued by previous versions for bulk revocation"`
+	SetIssuanceQuota         SetIssuanceQuotaCommand         `cmd:"" name:"set-issuance-quota" help:"set issuance quota of credential service or template"`
+	GrantRole                GrantRoleCommand                `cmd:"" name:"grant-role" help:"assign roles on template to account"`
+	RevokeRole               RevokeRoleCommand               `cmd:"" name:"revoke-role" help:"withdraw roles on template from account"`
+	Issue                    IssueCommand                    `cmd:"" name:"issue" help:"issue credential"`
+	Revoke                   RevokeCredentialsCommand        `cmd:"" name:"revoke" help:"revoke credential"`
+	RevokeAllByTemplate      RevokeAllByTemplateCommand      `cmd:"" name:"revoke-all-by-template" help:"revoke credentials of template at once"`
+	RevokeAllByHolder        RevokeAllByHolderCommand        `cmd:"" name:"revoke-all-by-holder" help:"revoke credentials of holder at once"`
+	Reissue                  ReissueCommand                  `cmd:"" name:"reissue" help:"revoke credential as superseded by new credential"`
+	Suspend                  SuspendCredentialsCommand       `cmd:"" name:"suspend" help:"suspend credential"`
+	Reinstate                ReinstateCredentialsCommand     `cmd:"" name:"reinstate" help:"reinstate suspended credential"`
+	Renew                    RenewCredentialsCommand         `cmd:"" name:"renew" help:"extend validity period of active credential"`
+	AmendCredential          AmendCredentialCommand          `cmd:"" name:"amend-credential" help:"replace value of active credential"`
+	AuditCredential          AuditCredentialCommand          `cmd:"" name:"audit-credential" help:"approve pending credential as template auditor"`
+	OfferCredential          OfferCredentialCommand          `cmd:"" name:"offer-credential" help:"offer credential to holder of template requiring consent"`
+	AcceptCredential         AcceptCredentialCommand         `cmd:"" name:"accept-credential" help:"accept credential offer as holder"`
+	DeclineCredential        DeclineCredentialCommand        `cmd:"" name:"decline-credential" help:"decline credential offer as holder"`
+	RequestCredential        RequestCredentialCommand        `cmd:"" name:"request-credential" help:"request credential to issuers of template as holder"`
+	ApproveCredentialRequest ApproveCredentialRequestCommand `cmd:"" name:"approve-credential-request" help:"approve credential request by issuing credential"`
+	RejectCredentialRequest  RejectCredentialRequestCommand  `cmd:"" name:"reject-credential-request" help:"reject credential request"`
+	Renounce                 RenounceCommand                 `cmd:"" name:"renounce" help:"renounce credential as holder"`
 }
@@ -0,0 +1,103 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-credential/operation/credential"
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type GrantRoleCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract   currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	TemplateID string                      `arg:"" name:"template-id" help:"template id" required:"true"`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Account    currencycmds.AddressFlag    `arg:"" name:"account" help:"account to be assigned roles" required:"true"`
+	Roles      []string                    `name:"role" help:"role of account; template-admin, issuer or revoker" required:""`
+	Restrict   bool                        `name:"restrict" help:"enable role-based access of template; handlers of contract lose authority on template" optional:""`
+	sender     base.Address
+	contract   base.Address
+	account    base.Address
+	roles      []types.Role
+}
+
+func (cmd *GrantRoleCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *GrantRoleCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	account, err := cmd.Account.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid account format, %q", cmd.Account.String())
+	}
+	cmd.account = account
+
+	roles := make([]types.Role, len(cmd.Roles))
+	for i := range cmd.Roles {
+		roles[i] = types.Role(cmd.Roles[i])
+	}
+	cmd.roles = roles
+
+	return nil
+}
+
+func (cmd *GrantRoleCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create grant-role operation")
+
+	fact := credential.NewGrantRoleFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.TemplateID,
+		cmd.account,
+		cmd.roles,
+		types.Bool(cmd.Restrict),
+		cmd.Currency.CID,
+	)
+
+	op := credential.NewGrantRole(fact)
+
+	err := op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -15,9 +15,12 @@ var SupportedProposalOperationFactHinters []encoder.DecodeDetail
 var AddedHinters = []encoder.DecodeDetail{
 	// revive:disable-next-line:line-length-limit
 	{Hint: types.CredentialHint, Instance: types.Credential{}},
+	{Hint: types.CredentialRefHint, Instance: types.CredentialRef{}},
 	{Hint: types.DesignHint, Instance: types.Design{}},
 	{Hint: types.HolderHint, Instance: types.Holder{}},
 	{Hint: types.PolicyHint, Instance: types.Policy{}},
+	{Hint: types.RevocationHint, Instance: types.Revocation{}},
+	{Hint: types.RoleAssignmentHint, Instance: types.RoleAssignment{}},
 	{Hint: types.TemplateHint, Instance: types.Template{}},
 
 	{Hint: credential.RegisterModelHint, Instance: credential.RegisterModel{}},
@@ -26,11 +29,50 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: credential.IssueHint, Instance: credential.Issue{}},
 	{Hint: credential.RevokeItemHint, Instance: credential.RevokeItem{}},
 	{Hint: credential.RevokeHint, Instance: credential.Revoke{}},
+	{Hint: credential.SuspendItemHint, Instance: credential.SuspendItem{}},
+	{Hint: credential.RenewItemHint, Instance: credential.RenewItem{}},
+	{Hint: credential.SuspendHint, Instance: credential.Suspend{}},
+	{Hint: credential.ReinstateItemHint, Instance: credential.ReinstateItem{}},
+	{Hint: credential.ReinstateHint, Instance: credential.Reinstate{}},
+	{Hint: credential.UpdateTemplateHint, Instance: credential.UpdateTemplate{}},
+	{Hint: credential.DeprecateTemplateHint, Instance: credential.DeprecateTemplate{}},
+	{Hint: credential.AuditCredentialItemHint, Instance: credential.AuditCredentialItem{}},
+	{Hint: credential.AuditCredentialHint, Instance: credential.AuditCredential{}},
+	{Hint: credential.ShareTemplateHint, Instance: credential.ShareTemplate{}},
+	{Hint: credential.GrantRoleHint, Instance: credential.GrantRole{}},
+	{Hint: credential.RevokeRoleHint, Instance: credential.RevokeRole{}},
+	{Hint: credential.OfferCredentialHint, Instance: credential.OfferCredential{}},
+	{Hint: credential.AcceptCredentialHint, Instance: credential.AcceptCredential{}},
+	{Hint: credential.DeclineCredentialHint, Instance: credential.DeclineCredential{}},
+	{Hint: credential.RequestCredentialHint, Instance: credential.RequestCredential{}},
+	{Hint: credential.ApproveCredentialRequestHint, Instance: credential.ApproveCredentialRequest{}},
+	{Hint: credential.RejectCredentialRequestHint, Instance: credential.RejectCredentialRequest{}},
+	{Hint: credential.RenounceHint, Instance: credential.Renounce{}},
+	{Hint: credential.RenewHint, Instance: credential.Renew{}},
+	{Hint: credential.AmendCredentialHint, Instance: credential.AmendCredential{}},
+	{Hint: credential.ReissueHint, Instance: credential.Reissue{}},
+	{Hint: credential.RevokeAllByTemplateHint, Instance: credential.RevokeAllByTemplate{}},
+	{Hint: credential.RevokeAllByHolderHint, Instance: credential.RevokeAllByHolder{}},
+	{Hint: credential.SetIssuanceQuotaHint, Instance: credential.SetIssuanceQuota{}},
+	{Hint: credential.PauseServiceHint, Instance: credential.PauseService{}},
+	{Hint: credential.ResumeServiceHint, Instance: credential.ResumeService{}},
+	{Hint: credential.CloseServiceHint, Instance: credential.CloseService{}},
+	{Hint: credential.IndexCredentialsHint, Instance: credential.IndexCredentials{}},
 
 	{Hint: state.CredentialStateValueHint, Instance: state.CredentialStateValue{}},
+	{Hint: state.CredentialStateValueV1Hint, Instance: state.CredentialStateValue{}},
 	{Hint: state.DesignStateValueHint, Instance: state.DesignStateValue{}},
 	{Hint: state.HolderDIDStateValueHint, Instance: state.HolderDIDStateValue{}},
+	{Hint: state.HolderStatStateValueHint, Instance: state.HolderStatStateValue{}},
+	{Hint: state.CredentialIndexStateValueHint, Instance: state.CredentialIndexStateValue{}},
+	{Hint: state.CredentialPagesStateValueHint, Instance: state.CredentialPagesStateValue{}},
+	{Hint: state.CredentialPageStateValueHint, Instance: state.CredentialPageStateValue{}},
+	{Hint: state.RevocationCursorStateValueHint, Instance: state.RevocationCursorStateValue{}},
 	{Hint: state.TemplateStateValueHint, Instance: state.TemplateStateValue{}},
+	{Hint: state.TemplateRolesStateValueHint, Instance: state.TemplateRolesStateValue{}},
+	{Hint: state.OfferStateValueHint, Instance: state.OfferStateValue{}},
+	{Hint: state.RequestStateValueHint, Instance: state.RequestStateValue{}},
+	{Hint: state.QuotaUsageStateValueHint, Instance: state.QuotaUsageStateValue{}},
 }
 
 var AddedSupportedHinters = []encoder.DecodeDetail{
@@ -38,6 +80,31 @@ var AddedSupportedHinters = []encoder.DecodeDetail{
 	{Hint: credential.IssueFactHint, Instance: credential.IssueFact{}},
 	{Hint: credential.RegisterModelFactHint, Instance: credential.RegisterModelFact{}},
 	{Hint: credential.RevokeFactHint, Instance: credential.RevokeFact{}},
+	{Hint: credential.SuspendFactHint, Instance: credential.SuspendFact{}},
+	{Hint: credential.ReinstateFactHint, Instance: credential.ReinstateFact{}},
+	{Hint: credential.UpdateTemplateFactHint, Instance: credential.UpdateTemplateFact{}},
+	{Hint: credential.DeprecateTemplateFactHint, Instance: credential.DeprecateTemplateFact{}},
+	{Hint: credential.AuditCredentialFactHint, Instance: credential.AuditCredentialFact{}},
+	{Hint: credential.ShareTemplateFactHint, Instance: credential.ShareTemplateFact{}},
+	{Hint: credential.GrantRoleFactHint, Instance: credential.GrantRoleFact{}},
+	{Hint: credential.RevokeRoleFactHint, Instance: credential.RevokeRoleFact{}},
+	{Hint: credential.OfferCredentialFactHint, Instance: credential.OfferCredentialFact{}},
+	{Hint: credential.AcceptCredentialFactHint, Instance: credential.AcceptCredentialFact{}},
+	{Hint: credential.DeclineCredentialFactHint, Instance: credential.DeclineCredentialFact{}},
+	{Hint: credential.RequestCredentialFactHint, Instance: credential.RequestCredentialFact{}},
+	{Hint: credential.ApproveCredentialRequestFactHint, Instance: credential.ApproveCredentialRequestFact{}},
+	{Hint: credential.RejectCredentialRequestFactHint, Instance: credential.RejectCredentialRequestFact{}},
+	{Hint: credential.RenounceFactHint, Instance: credential.RenounceFact{}},
+	{Hint: credential.RenewFactHint, Instance: credential.RenewFact{}},
+	{Hint: credential.AmendCredentialFactHint, Instance: credential.AmendCredentialFact{}},
+	{Hint: credential.ReissueFactHint, Instance: credential.ReissueFact{}},
+	{Hint: credential.RevokeAllByTemplateFactHint, Instance: credential.RevokeAllByTemplateFact{}},
+	{Hint: credential.RevokeAllByHolderFactHint, Instance: credential.RevokeAllByHolderFact{}},
+	{Hint: credential.SetIssuanceQuotaFactHint, Instance: credential.SetIssuanceQuotaFact{}},
+	{Hint: credential.PauseServiceFactHint, Instance: credential.PauseServiceFact{}},
+	{Hint: credential.ResumeServiceFactHint, Instance: credential.ResumeServiceFact{}},
+	{Hint: credential.CloseServiceFactHint, Instance: credential.CloseServiceFact{}},
+	{Hint: credential.IndexCredentialsFactHint, Instance: credential.IndexCredentialsFact{}},
 }
 
 func init() {
@@ -0,0 +1,94 @@
+package cmds
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ProtoconNet/mitum-credential/operation/credential"
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type IndexCredentialsCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender      currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract    currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	Currency    currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Credentials []string                    `name:"credential" help:"credential of previous versions to index; <template-id>:<credential-id>" required:""`
+	sender      base.Address
+	contract    base.Address
+	credentials []types.CredentialRef
+}
+
+func (cmd *IndexCredentialsCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *IndexCredentialsCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	cmd.credentials = make([]types.CredentialRef, len(cmd.Credentials))
+	for i := range cmd.Credentials {
+		l := strings.SplitN(cmd.Credentials[i], ":", 2)
+		if len(l) != 2 {
+			return errors.Errorf("invalid credential format, %q", cmd.Credentials[i])
+		}
+
+		cmd.credentials[i] = types.NewCredentialRef(l[0], l[1])
+	}
+
+	return nil
+}
+
+func (cmd *IndexCredentialsCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create index-credentials operation")
+
+	fact := credential.NewIndexCredentialsFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.credentials,
+		cmd.Currency.CID,
+	)
+
+	op := credential.NewIndexCredentials(fact)
+	err := op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
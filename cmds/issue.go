@@ -13,19 +13,21 @@ import (
 type IssueCommand struct {
 	BaseCommand
 	currencycmds.OperationFlags
-	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
-	Contract   currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract account address" required:"true"`
-	Holder     currencycmds.AddressFlag    `arg:"" name:"holder" help:"credential holder" required:"true"`
-	TemplateID string                      `arg:"" name:"template-id" help:"template id" required:"true"`
-	ID         string                      `arg:"" name:"id" help:"credential id" required:"true"`
-	Value      string                      `arg:"" name:"value" help:"credential value" required:"true"`
-	ValidFrom  uint64                      `arg:"" name:"valid-from" help:"valid from" required:"true"`
-	ValidUntil uint64                      `arg:"" name:"valid-until" help:"valid until" required:"true"`
-	DID        string                      `arg:"" name:"did" help:"did" required:"true"`
-	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	sender     base.Address
-	contract   base.Address
-	holder     base.Address
+	Sender           currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract         currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract account address" required:"true"`
+	Holder           currencycmds.AddressFlag    `arg:"" name:"holder" help:"credential holder" required:"true"`
+	TemplateID       string                      `arg:"" name:"template-id" help:"template id" required:"true"`
+	ID               string                      `arg:"" name:"id" help:"credential id" required:"true"`
+	Value            string                      `arg:"" name:"value" help:"credential value" required:"true"`
+	ValidFrom        uint64                      `arg:"" name:"valid-from" help:"valid from; unix time in seconds" required:"true"`
+	ValidUntil       uint64                      `arg:"" name:"valid-until" help:"valid until; unix time in seconds" required:"true"`
+	DID              string                      `arg:"" name:"did" help:"did" required:"true"`
+	Currency         currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	TemplateContract currencycmds.AddressFlag    `name:"template-contract" help:"contract account of shared template" optional:""`
+	sender           base.Address
+	contract         base.Address
+	holder           base.Address
+	templateContract base.Address
 }
 
 func (cmd *IssueCommand) Run(pctx context.Context) error {
@@ -70,6 +72,14 @@ func (cmd *IssueCommand) parseFlags() error {
 	}
 	cmd.holder = holder
 
+	if len(cmd.TemplateContract.String()) > 0 {
+		templateContract, err := cmd.TemplateContract.Encode(cmd.Encoders.JSON())
+		if err != nil {
+			return errors.Wrapf(err, "invalid template contract account format, %q", cmd.TemplateContract.String())
+		}
+		cmd.templateContract = templateContract
+	}
+
 	return nil
 }
 
@@ -86,6 +96,7 @@ func (cmd *IssueCommand) createOperation() (base.Operation, error) { // nolint:d
 		cmd.ValidFrom,
 		cmd.ValidUntil,
 		cmd.DID,
+		cmd.templateContract,
 		cmd.Currency.CID,
 	)
 	if err := item.IsValid(nil); err != nil {
@@ -32,11 +32,11 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		return pctx, err
 	}
 
-	//err := opr.SetCheckDuplicationFunc(processor.CheckDuplication)
-	//if err != nil {
-	//	return pctx, err
-	//}
-	err := opr.SetGetNewProcessorFunc(processor.GetNewProcessor)
+	err := opr.SetCheckDuplicationFunc(processor.CheckDuplication)
+	if err != nil {
+		return pctx, err
+	}
+	err = opr.SetGetNewProcessorFunc(processor.GetNewProcessor)
 	if err != nil {
 		return pctx, err
 	}
@@ -60,6 +60,131 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		credential.NewRevokeProcessor(),
 	); err != nil {
 		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.SuspendHint,
+		credential.NewSuspendProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.ReinstateHint,
+		credential.NewReinstateProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.UpdateTemplateHint,
+		credential.NewUpdateTemplateProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.DeprecateTemplateHint,
+		credential.NewDeprecateTemplateProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.AuditCredentialHint,
+		credential.NewAuditCredentialProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.ShareTemplateHint,
+		credential.NewShareTemplateProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.GrantRoleHint,
+		credential.NewGrantRoleProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.RevokeRoleHint,
+		credential.NewRevokeRoleProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.OfferCredentialHint,
+		credential.NewOfferCredentialProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.AcceptCredentialHint,
+		credential.NewAcceptCredentialProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.DeclineCredentialHint,
+		credential.NewDeclineCredentialProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.RequestCredentialHint,
+		credential.NewRequestCredentialProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.ApproveCredentialRequestHint,
+		credential.NewApproveCredentialRequestProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.RejectCredentialRequestHint,
+		credential.NewRejectCredentialRequestProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.RenounceHint,
+		credential.NewRenounceProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.RenewHint,
+		credential.NewRenewProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.AmendCredentialHint,
+		credential.NewAmendCredentialProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.ReissueHint,
+		credential.NewReissueProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.RevokeAllByTemplateHint,
+		credential.NewRevokeAllByTemplateProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.RevokeAllByHolderHint,
+		credential.NewRevokeAllByHolderProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.SetIssuanceQuotaHint,
+		credential.NewSetIssuanceQuotaProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.PauseServiceHint,
+		credential.NewPauseServiceProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.ResumeServiceHint,
+		credential.NewResumeServiceProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.CloseServiceHint,
+		credential.NewCloseServiceProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.IndexCredentialsHint,
+		credential.NewIndexCredentialsProcessor(),
+	); err != nil {
+		return pctx, err
 	}
 
 	_ = set.Add(credential.RegisterModelHint,
@@ -102,6 +227,256 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 			)
 		})
 
+	_ = set.Add(credential.SuspendHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.ReinstateHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.UpdateTemplateHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.DeprecateTemplateHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.AuditCredentialHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.ShareTemplateHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.GrantRoleHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.RevokeRoleHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.OfferCredentialHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.AcceptCredentialHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.DeclineCredentialHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.RequestCredentialHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.ApproveCredentialRequestHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.RejectCredentialRequestHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.RenounceHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.RenewHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.AmendCredentialHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.ReissueHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.RevokeAllByTemplateHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.RevokeAllByHolderHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.SetIssuanceQuotaHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.PauseServiceHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.ResumeServiceHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.CloseServiceHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(credential.IndexCredentialsHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
 	pctx = context.WithValue(pctx, currencycmds.OperationProcessorContextKey, opr)
 	pctx = context.WithValue(pctx, launch.OperationProcessorsMapContextKey, set) //revive:disable-line:modifies-parameter
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/ProtoconNet/mitum-credential/operation/credential"
+	"github.com/ProtoconNet/mitum-credential/types"
 	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/pkg/errors"
@@ -18,6 +19,8 @@ type RevokeCredentialsCommand struct {
 	TemplateID string                      `arg:"" name:"template-id" help:"template id" required:"true"`
 	ID         string                      `arg:"" name:"id" help:"credential id" required:"true"`
 	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Reason     string                      `name:"reason" help:"revocation reason; keyCompromise | superseded | cessationOfOperation | ..." optional:""`
+	Note       string                      `name:"note" help:"revocation note" optional:""`
 	sender     base.Address
 	contract   base.Address
 	holder     base.Address
@@ -79,6 +82,8 @@ func (cmd *RevokeCredentialsCommand) createOperation() (base.Operation, error) {
 		cmd.holder,
 		cmd.TemplateID,
 		cmd.ID,
+		types.RevocationReason(cmd.Reason),
+		cmd.Note,
 		cmd.Currency.CID,
 	)
 	if err := item.IsValid(nil); err != nil {
@@ -0,0 +1,101 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-credential/operation/credential"
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type RevokeRoleCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract   currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	TemplateID string                      `arg:"" name:"template-id" help:"template id" required:"true"`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Account    currencycmds.AddressFlag    `arg:"" name:"account" help:"account whose roles are withdrawn" required:"true"`
+	Roles      []string                    `name:"role" help:"role of account; template-admin, issuer or revoker" required:""`
+	sender     base.Address
+	contract   base.Address
+	account    base.Address
+	roles      []types.Role
+}
+
+func (cmd *RevokeRoleCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *RevokeRoleCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	account, err := cmd.Account.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid account format, %q", cmd.Account.String())
+	}
+	cmd.account = account
+
+	roles := make([]types.Role, len(cmd.Roles))
+	for i := range cmd.Roles {
+		roles[i] = types.Role(cmd.Roles[i])
+	}
+	cmd.roles = roles
+
+	return nil
+}
+
+func (cmd *RevokeRoleCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create revoke-role operation")
+
+	fact := credential.NewRevokeRoleFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.TemplateID,
+		cmd.account,
+		cmd.roles,
+		cmd.Currency.CID,
+	)
+
+	op := credential.NewRevokeRole(fact)
+
+	err := op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -0,0 +1,87 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-credential/operation/credential"
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type SetIssuanceQuotaCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender         currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract       currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	Currency       currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	TemplateID     string                      `name:"template" help:"template id; quota of credential service if not set" optional:""`
+	MaxCredentials uint64                      `name:"max-credentials" help:"maximum number of credentials issued; unlimited if not set" optional:""`
+	MaxIssuances   uint64                      `name:"max-issuances" help:"maximum number of credentials issued per period" optional:""`
+	Period         uint64                      `name:"period" help:"issuance period in blocks" optional:""`
+	sender         base.Address
+	contract       base.Address
+}
+
+func (cmd *SetIssuanceQuotaCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *SetIssuanceQuotaCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	return nil
+}
+
+func (cmd *SetIssuanceQuotaCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create set-issuance-quota operation")
+
+	fact := credential.NewSetIssuanceQuotaFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.TemplateID,
+		types.NewQuota(cmd.MaxCredentials, cmd.MaxIssuances, cmd.Period),
+		cmd.Currency.CID,
+	)
+
+	op := credential.NewSetIssuanceQuota(fact)
+
+	err := op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -0,0 +1,100 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-credential/operation/credential"
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type UpdateTemplateCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender           currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract         currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	TemplateID       string                      `arg:"" name:"template-id" help:"template id" required:"true"`
+	TemplateName     string                      `arg:"" name:"template-name" help:"template name"  required:"true"`
+	ExpirationDate   string                      `arg:"" name:"expiration-date" help:"expiration date; yyyy-MM-dd" required:"true"`
+	DisplayName      string                      `arg:"" name:"display-name" help:"display name" required:"true"`
+	Description      string                      `arg:"" name:"description" help:"description"  required:"true"`
+	Currency         currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	ExpirationHeight uint64                      `name:"expiration-height" help:"last block height in which template issues credentials" optional:""`
+	sender           base.Address
+	contract         base.Address
+	expiration       types.Date
+}
+
+func (cmd *UpdateTemplateCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateTemplateCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	expiration := types.Date(cmd.ExpirationDate)
+	if err := expiration.IsValid(nil); err != nil {
+		return errors.Wrapf(err, "invalid expiration date format, %q", cmd.ExpirationDate)
+	}
+	cmd.expiration = expiration
+
+	return nil
+}
+
+func (cmd *UpdateTemplateCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create update-template operation")
+
+	fact := credential.NewUpdateTemplateFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.TemplateID,
+		cmd.TemplateName,
+		cmd.expiration,
+		base.Height(cmd.ExpirationHeight),
+		cmd.DisplayName,
+		cmd.Description,
+		cmd.Currency.CID,
+	)
+
+	op := credential.NewUpdateTemplate(fact)
+
+	err := op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
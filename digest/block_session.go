@@ -25,27 +25,35 @@ var bulkWriteLimit = 500
 
 type BlockSession struct {
 	sync.RWMutex
-	block                 mitumbase.BlockMap
-	ops                   []mitumbase.Operation
-	opstree               fixedtree.Tree
-	sts                   []mitumbase.State
-	st                    *currencydigest.Database
-	proposal              mitumbase.ProposalSignFact
-	opsTreeNodes          map[string]mitumbase.OperationFixedtreeNode
-	blockModels           []mongo.WriteModel
-	operationModels       []mongo.WriteModel
-	accountModels         []mongo.WriteModel
-	balanceModels         []mongo.WriteModel
-	currencyModels        []mongo.WriteModel
-	contractAccountModels []mongo.WriteModel
-	didIssuerModels       []mongo.WriteModel
-	didCredentialModels   []mongo.WriteModel
-	didHolderDIDModels    []mongo.WriteModel
-	didTemplateModels     []mongo.WriteModel
-	statesValue           *sync.Map
-	balanceAddressList    []string
-	credentialMap         map[string]struct{}
-	buildinfo             string
+	block                  mitumbase.BlockMap
+	ops                    []mitumbase.Operation
+	opstree                fixedtree.Tree
+	sts                    []mitumbase.State
+	st                     *currencydigest.Database
+	proposal               mitumbase.ProposalSignFact
+	opsTreeNodes           map[string]mitumbase.OperationFixedtreeNode
+	blockModels            []mongo.WriteModel
+	operationModels        []mongo.WriteModel
+	accountModels          []mongo.WriteModel
+	balanceModels          []mongo.WriteModel
+	currencyModels         []mongo.WriteModel
+	contractAccountModels  []mongo.WriteModel
+	didIssuerModels        []mongo.WriteModel
+	didCredentialModels    []mongo.WriteModel
+	didHistoryModels       []mongo.WriteModel
+	didHolderDIDModels     []mongo.WriteModel
+	didTemplateModels      []mongo.WriteModel
+	didTemplateRolesModels []mongo.WriteModel
+	didOfferModels         []mongo.WriteModel
+	didRequestModels       []mongo.WriteModel
+	didQuotaUsageModels    []mongo.WriteModel
+	didStatusListModels    []mongo.WriteModel
+	statesValue            *sync.Map
+	balanceAddressList     []string
+	credentialMap          map[string]struct{}
+	statusListMap          map[string]struct{}
+	requestMap             map[string]struct{}
+	buildinfo              string
 }
 
 func NewBlockSession(
@@ -75,6 +83,8 @@ func NewBlockSession(
 		proposal:      proposal,
 		statesValue:   &sync.Map{},
 		credentialMap: map[string]struct{}{},
+		statusListMap: map[string]struct{}{},
+		requestMap:    map[string]struct{}{},
 		buildinfo:     vs,
 	}, nil
 }
@@ -137,6 +147,56 @@ func (bs *BlockSession) Commit(_ context.Context) error {
 			}
 		}
 
+		if len(bs.didHistoryModels) > 0 {
+			// NOTE the history keeps the states of the previous heights, so
+			// only the documents of this height are removed.
+			for key := range bs.credentialMap {
+				parsedKey, err := crcystate.ParseStateKey(key, state.CredentialPrefix, 5)
+				if err != nil {
+					return nil, err
+				}
+				err = bs.st.CleanByHeightColName(
+					txnCtx,
+					bs.block.Manifest().Height(),
+					defaultColNameDIDCredentialHistory,
+					bson.D{{"height", bs.block.Manifest().Height()}},
+					bson.D{{"contract", parsedKey[1]}},
+					bson.D{{"template", parsedKey[2]}},
+					bson.D{{"credential_id", parsedKey[3]}},
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if err := bs.writeModels(txnCtx, defaultColNameDIDCredentialHistory, bs.didHistoryModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.didStatusListModels) > 0 {
+			for key := range bs.statusListMap {
+				parsedKey, err := crcystate.ParseStateKey(key, state.CredentialPrefix, 4)
+				if err != nil {
+					return nil, err
+				}
+				err = bs.st.CleanByHeightColName(
+					txnCtx,
+					bs.block.Manifest().Height(),
+					defaultColNameDIDStatusList,
+					bson.D{{"contract", parsedKey[1]}},
+					bson.D{{"template", parsedKey[2]}},
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if err := bs.writeModels(txnCtx, defaultColNameDIDStatusList, bs.didStatusListModels); err != nil {
+				return nil, err
+			}
+		}
+
 		if err := bs.writeModels(txnCtx, defaultColNameBlock, bs.blockModels); err != nil {
 			return nil, err
 		}
@@ -189,6 +249,47 @@ func (bs *BlockSession) Commit(_ context.Context) error {
 			}
 		}
 
+		if len(bs.didTemplateRolesModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameTemplateRoles, bs.didTemplateRolesModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.didQuotaUsageModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameDIDQuotaUsage, bs.didQuotaUsageModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.didOfferModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameDIDOffer, bs.didOfferModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.didRequestModels) > 0 {
+			for key := range bs.requestMap {
+				parsedKey, err := crcystate.ParseStateKey(key, state.CredentialPrefix, 4)
+				if err != nil {
+					return nil, err
+				}
+				err = bs.st.CleanByHeightColName(
+					txnCtx,
+					bs.block.Manifest().Height(),
+					defaultColNameDIDRequest,
+					bson.D{{"contract", parsedKey[1]}},
+					bson.D{{"request_id", parsedKey[2]}},
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if err := bs.writeModels(txnCtx, defaultColNameDIDRequest, bs.didRequestModels); err != nil {
+				return nil, err
+			}
+		}
+
 		return nil, nil
 	})
 
@@ -422,9 +523,17 @@ func (bs *BlockSession) close() error {
 	bs.contractAccountModels = nil
 	bs.didIssuerModels = nil
 	bs.didCredentialModels = nil
+	bs.didHistoryModels = nil
 	bs.didHolderDIDModels = nil
 	bs.didTemplateModels = nil
+	bs.didTemplateRolesModels = nil
+	bs.didOfferModels = nil
+	bs.didRequestModels = nil
+	bs.didQuotaUsageModels = nil
+	bs.didStatusListModels = nil
 	bs.credentialMap = nil
+	bs.requestMap = nil
+	bs.statusListMap = nil
 
 	return bs.st.Close()
 }
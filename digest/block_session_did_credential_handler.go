@@ -1,8 +1,13 @@
 package digest
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/ProtoconNet/mitum-credential/state"
+	crcystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
@@ -12,9 +17,14 @@ func (bs *BlockSession) prepareDIDCredential() error {
 	}
 
 	var didModels []mongo.WriteModel
-	var didCredentialModels []mongo.WriteModel
 	var didHolderDIDModels []mongo.WriteModel
 	var didTemplateModels []mongo.WriteModel
+	var didTemplateRolesModels []mongo.WriteModel
+	var didOfferModels []mongo.WriteModel
+	var didRequestModels []mongo.WriteModel
+	var didQuotaUsageModels []mongo.WriteModel
+	var didHistoryModels []mongo.WriteModel
+	var credentialStates []mitumbase.State
 
 	for i := range bs.sts {
 		st := bs.sts[i]
@@ -26,12 +36,13 @@ func (bs *BlockSession) prepareDIDCredential() error {
 			}
 			didModels = append(didModels, j...)
 		case state.IsStateCredentialKey(st.Key()):
-			j, err := bs.handleCredentialState(st)
+			bs.credentialMap[st.Key()] = struct{}{}
+			credentialStates = append(credentialStates, st)
+			j, err := bs.handleCredentialHistoryState(st)
 			if err != nil {
 				return err
 			}
-			bs.credentialMap[st.Key()] = struct{}{}
-			didCredentialModels = append(didCredentialModels, j...)
+			didHistoryModels = append(didHistoryModels, j...)
 
 		case state.IsStateHolderDIDKey(st.Key()):
 			j, err := bs.handleHolderDIDState(st)
@@ -45,15 +56,51 @@ func (bs *BlockSession) prepareDIDCredential() error {
 				return err
 			}
 			didTemplateModels = append(didTemplateModels, j...)
+		case state.IsStateTemplateRolesKey(st.Key()):
+			j, err := bs.handleTemplateRolesState(st)
+			if err != nil {
+				return err
+			}
+			didTemplateRolesModels = append(didTemplateRolesModels, j...)
+		case state.IsStateOfferKey(st.Key()):
+			j, err := bs.handleOfferState(st)
+			if err != nil {
+				return err
+			}
+			didOfferModels = append(didOfferModels, j...)
+		case state.IsStateRequestKey(st.Key()):
+			bs.requestMap[st.Key()] = struct{}{}
+			j, err := bs.handleRequestState(st)
+			if err != nil {
+				return err
+			}
+			didRequestModels = append(didRequestModels, j...)
+		case state.IsStateQuotaUsageKey(st.Key()):
+			j, err := bs.handleQuotaUsageState(st)
+			if err != nil {
+				return err
+			}
+			didQuotaUsageModels = append(didQuotaUsageModels, j...)
 		default:
 			continue
 		}
 	}
 
+	didCredentialModels, didStatusListModels, err := bs.handleCredentialStates(credentialStates)
+	if err != nil {
+		return err
+	}
+
 	bs.didIssuerModels = didModels
 	bs.didCredentialModels = didCredentialModels
+	bs.didHistoryModels = didHistoryModels
 	bs.didHolderDIDModels = didHolderDIDModels
 	bs.didTemplateModels = didTemplateModels
+	bs.didTemplateRolesModels = didTemplateRolesModels
+	bs.didOfferModels = didOfferModels
+	bs.didRequestModels = didRequestModels
+	bs.didQuotaUsageModels = didQuotaUsageModels
+	bs.didStatusListModels = didStatusListModels
 
 	return nil
 }
@@ -68,12 +115,85 @@ func (bs *BlockSession) handleDIDCredentialDesignState(st mitumbase.State) ([]mo
 	}
 }
 
-func (bs *BlockSession) handleCredentialState(st mitumbase.State) ([]mongo.WriteModel, error) {
-	if credentialDoc, err := NewCredentialDoc(st, bs.st.Encoder()); err != nil {
+// handleCredentialStates assigns the index of status list to the credentials
+// which have no index yet and updates the status lists of their templates. The
+// credentials are handled in the order of state key, so the same index is
+// assigned whenever the blocks are digested.
+func (bs *BlockSession) handleCredentialStates(sts []mitumbase.State) ([]mongo.WriteModel, []mongo.WriteModel, error) {
+	sort.Slice(sts, func(i, j int) bool {
+		return sts[i].Key() < sts[j].Key()
+	})
+
+	var credentialModels []mongo.WriteModel
+	var statusListKeys []string
+	statusLists := map[string]StatusList{}
+
+	for i := range sts {
+		st := sts[i]
+
+		cv, err := state.StateCredentialStateValue(st)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		parsedKey, err := crcystate.ParseStateKey(st.Key(), state.CredentialPrefix, 5)
+		if err != nil {
+			return nil, nil, err
+		}
+		contract, templateID, credentialID := parsedKey[1], parsedKey[2], parsedKey[3]
+
+		slKey := fmt.Sprintf("%s:%s:%s:%s", state.CredentialPrefix, contract, templateID, state.TemplateSuffix)
+		sl, found := statusLists[slKey]
+		if !found {
+			switch v, err := TemplateStatusList(bs.st, contract, templateID); {
+			case errors.Is(err, mongo.ErrNoDocuments):
+				sl = NewStatusList(contract, templateID)
+			case err != nil:
+				return nil, nil, err
+			default:
+				sl = *v
+			}
+
+			statusListKeys = append(statusListKeys, slKey)
+			bs.statusListMap[slKey] = struct{}{}
+		}
+
+		index, found, err := CredentialStatusIndex(bs.st, contract, templateID, credentialID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !found {
+			index = sl.Assign()
+		}
+
+		sl = sl.SetStatus(index, cv.Status)
+		statusLists[slKey] = sl
+
+		credentialDoc, err := NewCredentialDoc(st, index, bs.st.Encoder())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		credentialModels = append(credentialModels, mongo.NewInsertOneModel().SetDocument(credentialDoc))
+	}
+
+	statusListModels := make([]mongo.WriteModel, len(statusListKeys))
+	for i := range statusListKeys {
+		statusListModels[i] = mongo.NewInsertOneModel().SetDocument(
+			NewStatusListDoc(statusLists[statusListKeys[i]], bs.block.Manifest().Height()),
+		)
+	}
+
+	return credentialModels, statusListModels, nil
+}
+
+func (bs *BlockSession) handleCredentialHistoryState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if historyDoc, err := NewCredentialHistoryDoc(st, bs.st.Encoder()); err != nil {
 		return nil, err
 	} else {
 		return []mongo.WriteModel{
-			mongo.NewInsertOneModel().SetDocument(credentialDoc),
+			mongo.NewInsertOneModel().SetDocument(historyDoc),
 		}, nil
 	}
 }
@@ -97,3 +217,43 @@ func (bs *BlockSession) handleTemplateState(st mitumbase.State) ([]mongo.WriteMo
 		}, nil
 	}
 }
+
+func (bs *BlockSession) handleTemplateRolesState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if rolesDoc, err := NewTemplateRolesDoc(st, bs.st.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(rolesDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleOfferState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if offerDoc, err := NewOfferDoc(st, bs.st.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(offerDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleRequestState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if requestDoc, err := NewRequestDoc(st, bs.st.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(requestDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleQuotaUsageState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if usageDoc, err := NewQuotaUsageDoc(st, bs.st.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(usageDoc),
+		}, nil
+	}
+}
@@ -7,6 +7,7 @@ import (
 	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
 	"github.com/ProtoconNet/mitum-currency/v3/digest/util"
 	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -21,8 +22,14 @@ var (
 	defaultColNameBlock                = "digest_bm"
 	defaultColNameDIDCredentialService = "digest_did_issuer"
 	defaultColNameDIDCredential        = "digest_did_credential"
+	defaultColNameDIDCredentialHistory = "digest_did_credential_history"
 	defaultColNameHolder               = "digest_did_holder_did"
 	defaultColNameTemplate             = "digest_did_template"
+	defaultColNameDIDStatusList        = "digest_did_status_list"
+	defaultColNameTemplateRoles        = "digest_did_template_roles"
+	defaultColNameDIDOffer             = "digest_did_offer"
+	defaultColNameDIDRequest           = "digest_did_request"
+	defaultColNameDIDQuotaUsage        = "digest_did_quota_usage"
 )
 
 var maxLimit int64 = 50
@@ -58,13 +65,12 @@ func CredentialService(st *currencydigest.Database, contract string) (*types.Des
 	return design, nil
 }
 
-func Credential(st *currencydigest.Database, contract, templateID, credentialID string) (*types.Credential, bool, error) {
+func Credential(st *currencydigest.Database, contract, templateID, credentialID string) (*state.CredentialStateValue, error) {
 	filter := util.NewBSONFilter("contract", contract)
 	filter = filter.Add("template", templateID)
 	filter = filter.Add("credential_id", credentialID)
 
-	var credential *types.Credential
-	var isActive bool
+	var credential *state.CredentialStateValue
 	var sta mitumbase.State
 	var err error
 	if err = st.MongoClient().GetByFilter(
@@ -75,20 +81,53 @@ func Credential(st *currencydigest.Database, contract, templateID, credentialID
 			if err != nil {
 				return err
 			}
-			cre, active, err := state.StateCredentialValue(sta)
+			cv, err := state.StateCredentialStateValue(sta)
 			if err != nil {
 				return err
 			}
-			credential = &cre
-			isActive = active
+			credential = &cv
 			return nil
 		},
 		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
 	); err != nil {
-		return nil, false, err
+		return nil, err
 	}
 
-	return credential, isActive, nil
+	return credential, nil
+}
+
+// CredentialHistory calls callback with every state of the credential in the
+// order of height.
+func CredentialHistory(
+	st *currencydigest.Database,
+	contract, templateID, credentialID string,
+	callback func(state.CredentialStateValue, mitumbase.State) (bool, error),
+) error {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("template", templateID)
+	filter = filter.Add("credential_id", credentialID)
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("height", 1).D(),
+	)
+
+	return st.MongoClient().Find(
+		context.Background(),
+		defaultColNameDIDCredentialHistory,
+		filter.D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			st, err := currencydigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+			cv, err := state.StateCredentialStateValue(st)
+			if err != nil {
+				return false, err
+			}
+			return callback(cv, st)
+		},
+		opt,
+	)
 }
 
 func Template(st *currencydigest.Database, contract, templateID string) (*types.Template, error) {
@@ -121,6 +160,236 @@ func Template(st *currencydigest.Database, contract, templateID string) (*types.
 	return template, nil
 }
 
+// Offer returns the latest credential offer of credentialID.
+func Offer(st *currencydigest.Database, contract, templateID, credentialID string) (*state.OfferStateValue, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("template", templateID)
+	filter = filter.Add("credential_id", credentialID)
+
+	var offer *state.OfferStateValue
+	var sta mitumbase.State
+	var err error
+	if err = st.MongoClient().GetByFilter(
+		defaultColNameDIDOffer,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = currencydigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+			of, err := state.StateOfferValue(sta)
+			if err != nil {
+				return err
+			}
+			offer = &of
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// RequestsByService calls callback with the latest credential requests of
+// contract in the order of request ID. Only the requests of status are passed
+// when status is not empty.
+func RequestsByService(
+	st *currencydigest.Database,
+	contract,
+	status string,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(state.RequestStateValue, mitumbase.State) (bool, error),
+) error {
+	filter, err := buildRequestFilterByService(contract, status, offset, reverse)
+	if err != nil {
+		return err
+	}
+
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("request_id", sr).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
+	return st.MongoClient().Find(
+		context.Background(),
+		defaultColNameDIDRequest,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			st, err := currencydigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+			rq, err := state.StateRequestValue(st)
+			if err != nil {
+				return false, err
+			}
+			return callback(rq, st)
+		},
+		opt,
+	)
+}
+
+func buildRequestFilterByService(contract, status string, offset string, reverse bool) (bson.D, error) {
+	filterA := bson.A{}
+
+	filterA = append(filterA, bson.D{{"contract", contract}})
+
+	if len(status) > 0 {
+		filterA = append(filterA, bson.D{{"status", status}})
+	}
+
+	if len(offset) > 0 {
+		if !reverse {
+			filterA = append(filterA, bson.D{{"request_id", bson.D{{"$gt", offset}}}})
+		} else {
+			filterA = append(filterA, bson.D{{"request_id", bson.D{{"$lt", offset}}}})
+		}
+	}
+
+	return bson.D{{"$and", filterA}}, nil
+}
+
+// TemplateRolesByService calls callback with the role assignments of the
+// templates of contract in the order of height.
+func TemplateRolesByService(
+	st *currencydigest.Database,
+	contract string,
+	callback func([]types.RoleAssignment, mitumbase.State) (bool, error),
+) error {
+	filter := util.NewBSONFilter("contract", contract)
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("height", 1).D(),
+	)
+
+	return st.MongoClient().Find(
+		context.Background(),
+		defaultColNameTemplateRoles,
+		filter.D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			st, err := currencydigest.LoadState(cursor.Decode, st.Encoders())
+			if err != nil {
+				return false, err
+			}
+			assignments, err := state.StateTemplateRolesValue(st)
+			if err != nil {
+				return false, err
+			}
+			return callback(assignments, st)
+		},
+		opt,
+	)
+}
+
+// QuotaUsage returns the latest issuance quota usage of the credential
+// service of contract, or of templateID if it is not empty. nil is returned
+// when no credential is issued yet.
+func QuotaUsage(st *currencydigest.Database, contract, templateID string) (*state.QuotaUsageStateValue, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("template", templateID)
+
+	var usage *state.QuotaUsageStateValue
+	switch err := st.MongoClient().GetByFilter(
+		defaultColNameDIDQuotaUsage,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err := currencydigest.LoadState(res.Decode, st.Encoders())
+			if err != nil {
+				return err
+			}
+			qu, err := state.StateQuotaUsageValue(sta)
+			if err != nil {
+				return err
+			}
+			usage = &qu
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	default:
+		return usage, nil
+	}
+}
+
+// CredentialStatusIndex returns the index of credential in the status list of
+// template. found is false when the credential has no index yet.
+func CredentialStatusIndex(st *currencydigest.Database, contract, templateID, credentialID string) (uint64, bool, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("template", templateID)
+	filter = filter.Add("credential_id", credentialID)
+	filter = filter.Add("status_index", bson.D{{"$exists", true}})
+
+	var u struct {
+		StatusIndex uint64 `bson:"status_index"`
+	}
+	switch err := st.MongoClient().GetByFilter(
+		defaultColNameDIDCredential,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			return res.Decode(&u)
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return 0, false, nil
+	case err != nil:
+		return 0, false, err
+	default:
+		return u.StatusIndex, true, nil
+	}
+}
+
+// TemplateStatusList returns the latest status list of template.
+func TemplateStatusList(st *currencydigest.Database, contract, templateID string) (*StatusList, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("template", templateID)
+
+	var sl *StatusList
+	if err := st.MongoClient().GetByFilter(
+		defaultColNameDIDStatusList,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			var u StatusListDocBSONUnmarshaler
+			if err := res.Decode(&u); err != nil {
+				return err
+			}
+
+			i, err := u.StatusList()
+			if err != nil {
+				return err
+			}
+			sl = &i
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return sl, nil
+}
+
 func HolderDID(st *currencydigest.Database, contract, holder string) (string, error) {
 	filter := util.NewBSONFilter("contract", contract)
 	filter = filter.Add("holder", holder)
@@ -158,7 +427,7 @@ func CredentialsByServiceTemplate(
 	reverse bool,
 	offset string,
 	limit int64,
-	callback func(types.Credential, bool, mitumbase.State) (bool, error),
+	callback func(state.CredentialStateValue, mitumbase.State) (bool, error),
 ) error {
 	filter, err := buildCredentialFilterByServiceTemplate(contract, templateID, offset, reverse)
 	if err != nil {
@@ -191,11 +460,11 @@ func CredentialsByServiceTemplate(
 			if err != nil {
 				return false, err
 			}
-			credential, isActive, err := state.StateCredentialValue(st)
+			cv, err := state.StateCredentialStateValue(st)
 			if err != nil {
 				return false, err
 			}
-			return callback(credential, isActive, st)
+			return callback(cv, st)
 		},
 		opt,
 	)
@@ -238,7 +507,7 @@ func buildCredentialFilterByServiceTemplate(contract, templateID string, offset
 func CredentialsByServiceHolder(
 	st *currencydigest.Database,
 	contract, holder string,
-	callback func(types.Credential, bool, mitumbase.State) (bool, error),
+	callback func(state.CredentialStateValue, mitumbase.State) (bool, error),
 ) error {
 	filter, err := buildCredentialFilterByServiceHolder(contract, holder)
 	if err != nil {
@@ -260,11 +529,11 @@ func CredentialsByServiceHolder(
 			if err != nil {
 				return false, err
 			}
-			credential, isActive, err := state.StateCredentialValue(st)
+			cv, err := state.StateCredentialStateValue(st)
 			if err != nil {
 				return false, err
 			}
-			return callback(credential, isActive, st)
+			return callback(cv, st)
 		},
 		opt,
 	)
@@ -8,6 +8,7 @@ import (
 	crcystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util/encoder"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 type DIDCredentialDesignDoc struct {
@@ -83,6 +84,177 @@ func (doc TemplateDoc) MarshalBSON() ([]byte, error) {
 
 	m["contract"] = parsedKey[1]
 	m["template"] = parsedKey[2]
+	m["deprecated"] = doc.template.Deprecated()
+	m["schema_hash"] = doc.template.SchemaHash()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type TemplateRolesDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+}
+
+func NewTemplateRolesDoc(st base.State, enc encoder.Encoder) (*TemplateRolesDoc, error) {
+	if _, err := state.StateTemplateRolesValue(st); err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateRolesDoc{
+		BaseDoc: b,
+		st:      st,
+	}, nil
+}
+
+func (doc TemplateRolesDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.CredentialPrefix, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["template"] = parsedKey[2]
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type OfferDoc struct {
+	mongodbstorage.BaseDoc
+	st    base.State
+	offer state.OfferStateValue
+}
+
+func NewOfferDoc(st base.State, enc encoder.Encoder) (*OfferDoc, error) {
+	offer, err := state.StateOfferValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OfferDoc{
+		BaseDoc: b,
+		st:      st,
+		offer:   offer,
+	}, nil
+}
+
+func (doc OfferDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.CredentialPrefix, 5)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["template"] = parsedKey[2]
+	m["credential_id"] = parsedKey[3]
+	m["holder"] = doc.offer.Credential.Holder().String()
+	m["status"] = doc.offer.Status
+	m["expires_at"] = doc.offer.ExpiresAt
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type RequestDoc struct {
+	mongodbstorage.BaseDoc
+	st      base.State
+	request state.RequestStateValue
+}
+
+func NewRequestDoc(st base.State, enc encoder.Encoder) (*RequestDoc, error) {
+	request, err := state.StateRequestValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RequestDoc{
+		BaseDoc: b,
+		st:      st,
+		request: request,
+	}, nil
+}
+
+func (doc RequestDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.CredentialPrefix, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["request_id"] = parsedKey[2]
+	m["template"] = doc.request.TemplateID
+	m["holder"] = doc.request.Holder.String()
+	m["status"] = doc.request.Status
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type QuotaUsageDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+}
+
+func NewQuotaUsageDoc(st base.State, enc encoder.Encoder) (*QuotaUsageDoc, error) {
+	if _, err := state.StateQuotaUsageValue(st); err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuotaUsageDoc{
+		BaseDoc: b,
+		st:      st,
+	}, nil
+}
+
+func (doc QuotaUsageDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.CredentialPrefix, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE the usage of the credential service has no template.
+	m["contract"] = parsedKey[1]
+	m["template"] = ""
+	if len(parsedKey) > 3 {
+		m["template"] = parsedKey[2]
+	}
 	m["height"] = doc.st.Height()
 
 	return bsonenc.Marshal(m)
@@ -90,13 +262,14 @@ func (doc TemplateDoc) MarshalBSON() ([]byte, error) {
 
 type CredentialDoc struct {
 	mongodbstorage.BaseDoc
-	st         base.State
-	credential types.Credential
-	isActive   bool
+	st          base.State
+	credential  types.Credential
+	status      types.CredentialStatus
+	statusIndex uint64
 }
 
-func NewCredentialDoc(st base.State, enc encoder.Encoder) (*CredentialDoc, error) {
-	credential, isActive, err := state.StateCredentialValue(st)
+func NewCredentialDoc(st base.State, statusIndex uint64, enc encoder.Encoder) (*CredentialDoc, error) {
+	credential, status, err := state.StateCredentialValue(st)
 	if err != nil {
 		return nil, err
 	}
@@ -106,10 +279,11 @@ func NewCredentialDoc(st base.State, enc encoder.Encoder) (*CredentialDoc, error
 	}
 
 	return &CredentialDoc{
-		BaseDoc:    b,
-		st:         st,
-		credential: credential,
-		isActive:   isActive,
+		BaseDoc:     b,
+		st:          st,
+		credential:  credential,
+		status:      status,
+		statusIndex: statusIndex,
 	}, nil
 }
 
@@ -123,15 +297,72 @@ func (doc CredentialDoc) MarshalBSON() ([]byte, error) {
 		return nil, err
 	}
 
+	m["contract"] = parsedKey[1]
+	m["template"] = parsedKey[2]
+	m["template_contract"] = CredentialTemplateContract(parsedKey[1], doc.credential)
+	m["credential_id"] = parsedKey[3]
+	m["status"] = doc.status
+	m["status_index"] = doc.statusIndex
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+// CredentialHistoryDoc keeps every state of the credential; unlike
+// CredentialDoc, the documents of the previous heights are not removed.
+type CredentialHistoryDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	cv state.CredentialStateValue
+}
+
+func NewCredentialHistoryDoc(st base.State, enc encoder.Encoder) (*CredentialHistoryDoc, error) {
+	cv, err := state.StateCredentialStateValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CredentialHistoryDoc{
+		BaseDoc: b,
+		st:      st,
+		cv:      cv,
+	}, nil
+}
+
+func (doc CredentialHistoryDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.CredentialPrefix, 5)
+	if err != nil {
+		return nil, err
+	}
+
 	m["contract"] = parsedKey[1]
 	m["template"] = parsedKey[2]
 	m["credential_id"] = parsedKey[3]
-	m["is_active"] = doc.isActive
+	m["version"] = doc.cv.Version
+	m["status"] = doc.cv.Status
 	m["height"] = doc.st.Height()
 
 	return bsonenc.Marshal(m)
 }
 
+// CredentialTemplateContract returns the contract account which published the
+// template of credential issued by contract.
+func CredentialTemplateContract(contract string, credential types.Credential) string {
+	if tc := credential.TemplateContract(); tc != nil {
+		return tc.String()
+	}
+
+	return contract
+}
+
 type HolderDIDDoc struct {
 	mongodbstorage.BaseDoc
 	st  base.State
@@ -174,3 +405,65 @@ func (doc HolderDIDDoc) MarshalBSON() ([]byte, error) {
 
 	return bsonenc.Marshal(m)
 }
+
+type StatusListDoc struct {
+	sl     StatusList
+	height base.Height
+}
+
+func NewStatusListDoc(sl StatusList, height base.Height) StatusListDoc {
+	return StatusListDoc{
+		sl:     sl,
+		height: height,
+	}
+}
+
+func (doc StatusListDoc) MarshalBSON() ([]byte, error) {
+	revocation, err := doc.sl.Revocation.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	suspension, err := doc.sl.Suspension.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return bsonenc.Marshal(bson.M{
+		"contract":   doc.sl.Contract,
+		"template":   doc.sl.TemplateID,
+		"size":       doc.sl.Size,
+		"revocation": revocation,
+		"suspension": suspension,
+		"height":     doc.height,
+	})
+}
+
+type StatusListDocBSONUnmarshaler struct {
+	Contract   string `bson:"contract"`
+	Template   string `bson:"template"`
+	Size       uint64 `bson:"size"`
+	Revocation string `bson:"revocation"`
+	Suspension string `bson:"suspension"`
+	Height     int64  `bson:"height"`
+}
+
+func (u StatusListDocBSONUnmarshaler) StatusList() (StatusList, error) {
+	revocation, err := DecodeBitstring(u.Revocation)
+	if err != nil {
+		return StatusList{}, err
+	}
+
+	suspension, err := DecodeBitstring(u.Suspension)
+	if err != nil {
+		return StatusList{}, err
+	}
+
+	return StatusList{
+		Contract:   u.Contract,
+		TemplateID: u.Template,
+		Size:       u.Size,
+		Revocation: revocation,
+		Suspension: suspension,
+	}, nil
+}
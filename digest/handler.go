@@ -23,11 +23,17 @@ import (
 )
 
 var (
-	HandlerPathDIDService     = `/did/{contract:(?i)` + types.REStringAddressString + `}`
-	HandlerPathDIDCredential  = `/did/{contract:(?i)` + types.REStringAddressString + `}/template/{template_id:` + types.ReSpecialCh + `}/credential/{credential_id:` + types.ReSpecialCh + `}`
-	HandlerPathDIDTemplate    = `/did/{contract:(?i)` + types.REStringAddressString + `}/template/{template_id:` + types.ReSpecialCh + `}`
-	HandlerPathDIDCredentials = `/did/{contract:(?i)` + types.REStringAddressString + `}/template/{template_id:` + types.ReSpecialCh + `}/credentials`
-	HandlerPathDIDHolder      = `/did/{contract:(?i)` + types.REStringAddressString + `}/holder/{holder:(?i)` + types.REStringAddressString + `}` // revive:disable-line:line-length-limit
+	HandlerPathDIDService      = `/did/{contract:(?i)` + types.REStringAddressString + `}`
+	HandlerPathDIDCredential   = `/did/{contract:(?i)` + types.REStringAddressString + `}/template/{template_id:` + types.ReSpecialCh + `}/credential/{credential_id:` + types.ReSpecialCh + `}`
+	HandlerPathDIDCredentialVC = HandlerPathDIDCredential + `/vc`
+	HandlerPathDIDHistory      = HandlerPathDIDCredential + `/history`
+	HandlerPathDIDTemplate     = `/did/{contract:(?i)` + types.REStringAddressString + `}/template/{template_id:` + types.ReSpecialCh + `}`
+	HandlerPathDIDCredentials  = `/did/{contract:(?i)` + types.REStringAddressString + `}/template/{template_id:` + types.ReSpecialCh + `}/credentials`
+	HandlerPathDIDStatusList   = `/did/{contract:(?i)` + types.REStringAddressString + `}/template/{template_id:` + types.ReSpecialCh + `}/status-list/{purpose:(?:revocation|suspension)}` // revive:disable-line:line-length-limit
+	HandlerPathDIDOffer        = `/did/{contract:(?i)` + types.REStringAddressString + `}/template/{template_id:` + types.ReSpecialCh + `}/offer/{credential_id:` + types.ReSpecialCh + `}`
+	HandlerPathDIDRoles        = `/did/{contract:(?i)` + types.REStringAddressString + `}/roles`
+	HandlerPathDIDRequests     = `/did/{contract:(?i)` + types.REStringAddressString + `}/requests`
+	HandlerPathDIDHolder       = `/did/{contract:(?i)` + types.REStringAddressString + `}/holder/{holder:(?i)` + types.REStringAddressString + `}` // revive:disable-line:line-length-limit
 )
 
 func init() {
@@ -125,10 +131,22 @@ func (hd *Handlers) setHandlers() {
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathDIDCredential, hd.handleCredential, true, get, get).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDIDCredentialVC, hd.handleCredentialVC, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDIDHistory, hd.handleCredentialHistory, true, get, get).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathDIDHolder, hd.handleHolderCredential, true, get, get).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathDIDTemplate, hd.handleTemplate, true, get, get).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDIDStatusList, hd.handleStatusList, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDIDRoles, hd.handleTemplateRoles, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDIDOffer, hd.handleOffer, true, get, get).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDIDRequests, hd.handleRequests, true, get, get).
+		Methods(http.MethodOptions, "GET")
 }
 
 func (hd *Handlers) setHandler(prefix string, h network.HTTPHandlerFunc, useCache bool, rps, burst int) *mux.Route {
@@ -1,14 +1,18 @@
 package digest
 
 import (
+	"github.com/ProtoconNet/mitum-credential/state"
 	"github.com/ProtoconNet/mitum-credential/types"
 	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
+	crcystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	mitumutil "github.com/ProtoconNet/mitum2/util"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 func (hd *Handlers) handleCredentialService(w http.ResponseWriter, r *http.Request) {
@@ -57,11 +61,83 @@ func (hd *Handlers) buildCredentialServiceHal(contract string, design types.Desi
 		return nil, err
 	}
 
-	hal := currencydigest.NewBaseHal(design, currencydigest.NewHalLink(h, nil))
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(design, currencydigest.NewHalLink(h, nil))
+
+	quotas, err := hd.buildQuotaHalValues(contract, design)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(quotas) > 0 {
+		hal = hal.AddExtras("quotas", quotas)
+	}
 
 	return hal, nil
 }
 
+type quotaHalValue struct {
+	TemplateID     string      `json:"template_id,omitempty"`
+	MaxCredentials uint64      `json:"max_credentials,omitempty"`
+	MaxIssuances   uint64      `json:"max_issuances,omitempty"`
+	IssuancePeriod uint64      `json:"issuance_period,omitempty"`
+	Issued         uint64      `json:"issued"`
+	PeriodStart    base.Height `json:"period_start"`
+	PeriodIssued   uint64      `json:"period_issued"`
+}
+
+// buildQuotaHalValues returns the issuance quotas of the credential service
+// and of its templates with the current usages.
+func (hd *Handlers) buildQuotaHalValues(contract string, design types.Design) ([]quotaHalValue, error) {
+	var vs []quotaHalValue
+
+	add := func(templateID string, quota types.Quota) error {
+		if quota.IsEmpty() {
+			return nil
+		}
+
+		usage := state.NewQuotaUsageStateValue(0, base.GenesisHeight, 0)
+		switch u, err := QuotaUsage(hd.database, contract, templateID); {
+		case err != nil:
+			return err
+		case u != nil:
+			usage = *u
+		}
+
+		// NOTE the usage is the one of the next block.
+		usage = usage.At(quota, hd.database.LastBlock()+1)
+
+		vs = append(vs, quotaHalValue{
+			TemplateID:     templateID,
+			MaxCredentials: quota.MaxCredentials(),
+			MaxIssuances:   quota.MaxIssuances(),
+			IssuancePeriod: quota.Period(),
+			Issued:         usage.Issued,
+			PeriodStart:    usage.PeriodStart,
+			PeriodIssued:   usage.PeriodIssued,
+		})
+
+		return nil
+	}
+
+	if err := add("", design.Quota()); err != nil {
+		return nil, err
+	}
+
+	for _, templateID := range design.Policy().TemplateIDs() {
+		template, err := Template(hd.database, contract, templateID)
+		if err != nil {
+			return nil, mitumutil.ErrNotFound.WithMessage(err, "template %s, contract %s", templateID, contract)
+		}
+
+		if err := add(templateID, template.Quota()); err != nil {
+			return nil, err
+		}
+	}
+
+	return vs, nil
+}
+
 func (hd *Handlers) handleCredential(w http.ResponseWriter, r *http.Request) {
 	cacheKey := currencydigest.CacheKeyPath(r)
 	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
@@ -99,13 +175,13 @@ func (hd *Handlers) handleCredential(w http.ResponseWriter, r *http.Request) {
 }
 
 func (hd *Handlers) handleCredentialInGroup(contract, templateID, credentialID string) (interface{}, error) {
-	switch credential, isActive, err := Credential(hd.database, contract, templateID, credentialID); {
+	switch credential, err := Credential(hd.database, contract, templateID, credentialID); {
 	case err != nil:
 		return nil, mitumutil.ErrNotFound.WithMessage(err, "credential by contract %s, template %s, id %s", contract, templateID, credentialID)
 	case credential == nil:
 		return nil, mitumutil.ErrNotFound.Errorf("credential by contract %s, template %s, id %s", contract, templateID, credentialID)
 	default:
-		hal, err := hd.buildCredentialHal(contract, *credential, isActive)
+		hal, err := hd.buildCredentialHal(contract, *credential)
 		if err != nil {
 			return nil, err
 		}
@@ -113,11 +189,23 @@ func (hd *Handlers) handleCredentialInGroup(contract, templateID, credentialID s
 	}
 }
 
+type credentialHalValue struct {
+	Credential       types.Credential       `json:"credential"`
+	TemplateContract string                 `json:"template_contract"`
+	Status           types.CredentialStatus `json:"status"`
+	Revocation       *types.Revocation      `json:"revocation,omitempty"`
+	Approvals        []base.Address         `json:"approvals,omitempty"`
+	Revision         uint64                 `json:"revision,omitempty"`
+	RenewedAt        base.Height            `json:"renewed_at,omitempty"`
+	Version          uint64                 `json:"version,omitempty"`
+}
+
 func (hd *Handlers) buildCredentialHal(
 	contract string,
-	credential types.Credential,
-	isActive bool,
+	cv state.CredentialStateValue,
 ) (currencydigest.Hal, error) {
+	credential := cv.Credential
+
 	h, err := hd.combineURL(
 		HandlerPathDIDCredential,
 		"contract", contract,
@@ -128,17 +216,240 @@ func (hd *Handlers) buildCredentialHal(
 		return nil, err
 	}
 
-	hal := currencydigest.NewBaseHal(
-		struct {
-			Credential types.Credential `json:"credential"`
-			IsActive   bool             `json:"is_active"`
-		}{Credential: credential, IsActive: isActive},
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(
+		credentialHalValue{
+			Credential:       credential,
+			TemplateContract: CredentialTemplateContract(contract, credential),
+			Status:           cv.Status,
+			Revocation:       cv.Revocation,
+			Approvals:        cv.Approvals,
+			Revision:         cv.Revision,
+			RenewedAt:        cv.RenewedAt,
+			Version:          cv.Version,
+		},
 		currencydigest.NewHalLink(h, nil),
 	)
 
+	// NOTE the credentials linked by Reissue are of the same template.
+	for rel, credentialID := range map[string]string{
+		"supersedes":    cv.Supersedes,
+		"superseded_by": cv.SupersededBy,
+	} {
+		if len(credentialID) < 1 {
+			continue
+		}
+
+		l, err := hd.combineURL(
+			HandlerPathDIDCredential,
+			"contract", contract,
+			"template_id", credential.TemplateID(),
+			"credential_id", credentialID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink(rel, currencydigest.NewHalLink(l, nil))
+	}
+
 	return hal, nil
 }
 
+func (hd *Handlers) handleCredentialHistory(w http.ResponseWriter, r *http.Request) {
+	cacheKey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	templateID, err, status := currencydigest.ParseRequest(w, r, "template_id")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	credentialID, err, status := currencydigest.ParseRequest(w, r, "credential_id")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleCredentialHistoryInGroup(contract, templateID, credentialID)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Second*3)
+		}
+	}
+}
+
+type credentialHistoryHalValue struct {
+	Version    uint64                 `json:"version"`
+	Value      string                 `json:"value"`
+	ValidFrom  uint64                 `json:"valid_from"`
+	ValidUntil uint64                 `json:"valid_until"`
+	Status     types.CredentialStatus `json:"status"`
+	Height     base.Height            `json:"height"`
+}
+
+func (hd *Handlers) handleCredentialHistoryInGroup(contract, templateID, credentialID string) (interface{}, error) {
+	var vs []credentialHistoryHalValue
+	if err := CredentialHistory(
+		hd.database, contract, templateID, credentialID,
+		func(cv state.CredentialStateValue, st base.State) (bool, error) {
+			vs = append(vs, credentialHistoryHalValue{
+				Version:    cv.Version,
+				Value:      cv.Credential.Value(),
+				ValidFrom:  cv.Credential.ValidFrom(),
+				ValidUntil: cv.Credential.ValidUntil(),
+				Status:     cv.Status,
+				Height:     st.Height(),
+			})
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(
+			err, "credential history by contract %s, template %s, id %s", contract, templateID, credentialID)
+	}
+
+	if len(vs) < 1 {
+		return nil, mitumutil.ErrNotFound.Errorf(
+			"credential history by contract %s, template %s, id %s", contract, templateID, credentialID)
+	}
+
+	h, err := hd.combineURL(
+		HandlerPathDIDHistory,
+		"contract", contract,
+		"template_id", templateID,
+		"credential_id", credentialID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(
+		struct {
+			History []credentialHistoryHalValue `json:"history"`
+		}{
+			History: vs,
+		}, currencydigest.NewHalLink(h, nil))
+
+	credential, err := hd.combineURL(
+		HandlerPathDIDCredential,
+		"contract", contract,
+		"template_id", templateID,
+		"credential_id", credentialID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("credential", currencydigest.NewHalLink(credential, nil))
+
+	return hd.encoder.Marshal(hal)
+}
+
+func (hd *Handlers) handleCredentialVC(w http.ResponseWriter, r *http.Request) {
+	baseURL := requestBaseURL(r)
+
+	cacheKey := currencydigest.CacheKey(currencydigest.CacheKeyPath(r), baseURL)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	templateID, err, status := currencydigest.ParseRequest(w, r, "template_id")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	credentialID, err, status := currencydigest.ParseRequest(w, r, "credential_id")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleCredentialVCInGroup(baseURL, contract, templateID, credentialID)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteVCBytes(w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleCredentialVCInGroup(baseURL, contract, templateID, credentialID string) (interface{}, error) {
+	credential, err := Credential(hd.database, contract, templateID, credentialID)
+	switch {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "credential by contract %s, template %s, id %s", contract, templateID, credentialID)
+	case credential == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("credential by contract %s, template %s, id %s", contract, templateID, credentialID)
+	}
+
+	templateContract := CredentialTemplateContract(contract, credential.Credential)
+	template, err := Template(hd.database, templateContract, templateID)
+	if err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "template by contract %s, template %s", templateContract, templateID)
+	}
+
+	var statusIndex *uint64
+	switch i, found, err := CredentialStatusIndex(hd.database, contract, templateID, credentialID); {
+	case err != nil:
+		return nil, err
+	case found:
+		statusIndex = &i
+	}
+
+	issuer, err := hd.combineURL(HandlerPathDIDService, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	statusList, err := hd.combineURL(
+		HandlerPathDIDStatusList,
+		"contract", contract,
+		"template_id", templateID,
+		"purpose", StatusPurposeRevocation,
+	)
+	if err != nil {
+		return nil, err
+	}
+	statusList = strings.TrimSuffix(statusList, "/"+StatusPurposeRevocation)
+
+	id, err := hd.combineURL(
+		HandlerPathDIDCredential,
+		"contract", contract,
+		"template_id", templateID,
+		"credential_id", credentialID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return hd.encoder.Marshal(
+		NewVerifiableCredential(baseURL+issuer, baseURL+id, *credential, template, baseURL+statusList, statusIndex),
+	)
+}
+
 func (hd *Handlers) handleCredentials(w http.ResponseWriter, r *http.Request) {
 	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
 	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
@@ -211,8 +522,8 @@ func (hd *Handlers) handleCredentialsInGroup(
 	var vas []currencydigest.Hal
 	if err := CredentialsByServiceTemplate(
 		hd.database, contract, templateID, reverse, offset, limit,
-		func(credential types.Credential, isActive bool, st base.State) (bool, error) {
-			hal, err := hd.buildCredentialHal(contract, credential, isActive)
+		func(cv state.CredentialStateValue, st base.State) (bool, error) {
+			hal, err := hd.buildCredentialHal(contract, cv)
 			if err != nil {
 				return false, err
 			}
@@ -270,10 +581,7 @@ func (hd *Handlers) buildCredentialsHal(
 	var nextOffset string
 
 	if len(vas) > 0 {
-		va, ok := vas[len(vas)-1].Interface().(struct {
-			Credential types.Credential `json:"credential"`
-			IsActive   bool             `json:"is_active"`
-		})
+		va, ok := vas[len(vas)-1].Interface().(credentialHalValue)
 		if !ok {
 			return nil, errors.Errorf("failed to build credentials hal")
 		}
@@ -340,8 +648,8 @@ func (hd *Handlers) handleHolderCredentialsInGroup(contract, holder string) (int
 	var vas []currencydigest.Hal
 	if err := CredentialsByServiceHolder(
 		hd.database, contract, holder,
-		func(credential types.Credential, isActive bool, st base.State) (bool, error) {
-			hal, err := hd.buildCredentialHal(contract, credential, isActive)
+		func(cv state.CredentialStateValue, st base.State) (bool, error) {
+			hal, err := hd.buildCredentialHal(contract, cv)
 			if err != nil {
 				return false, err
 			}
@@ -444,3 +752,452 @@ func (hd *Handlers) buildTemplateHal(
 
 	return hal, nil
 }
+
+func (hd *Handlers) handleStatusList(w http.ResponseWriter, r *http.Request) {
+	baseURL := requestBaseURL(r)
+
+	cacheKey := currencydigest.CacheKey(currencydigest.CacheKeyPath(r), baseURL)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	templateID, err, status := currencydigest.ParseRequest(w, r, "template_id")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	purpose, err, status := currencydigest.ParseRequest(w, r, "purpose")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleStatusListInGroup(baseURL, contract, templateID, purpose)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteVCBytes(w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleStatusListInGroup(baseURL, contract, templateID, purpose string) (interface{}, error) {
+	var sl StatusList
+	switch i, err := TemplateStatusList(hd.database, contract, templateID); {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		if _, err := Template(hd.database, contract, templateID); err != nil {
+			return nil, mitumutil.ErrNotFound.WithMessage(err, "template by contract %s, template %s", contract, templateID)
+		}
+
+		sl = NewStatusList(contract, templateID)
+	case err != nil:
+		return nil, err
+	default:
+		sl = *i
+	}
+
+	issuer, err := hd.combineURL(HandlerPathDIDService, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := hd.combineURL(
+		HandlerPathDIDStatusList,
+		"contract", contract,
+		"template_id", templateID,
+		"purpose", purpose,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	vc, err := NewStatusListCredential(baseURL+issuer, baseURL+id, purpose, sl)
+	if err != nil {
+		return nil, mitumutil.ErrNotFound.Wrap(err)
+	}
+
+	return hd.encoder.Marshal(vc)
+}
+
+func (hd *Handlers) handleTemplateRoles(w http.ResponseWriter, r *http.Request) {
+	cacheKey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleTemplateRolesInGroup(contract)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Second*3)
+		}
+	}
+}
+
+type templateRolesHalValue struct {
+	TemplateID  string                 `json:"template_id"`
+	Assignments []types.RoleAssignment `json:"assignments"`
+	Restricted  bool                   `json:"restricted"`
+}
+
+func (hd *Handlers) handleTemplateRolesInGroup(contract string) (interface{}, error) {
+	var templateIDs []string
+	latest := map[string]state.TemplateRolesStateValue{}
+	if err := TemplateRolesByService(
+		hd.database, contract,
+		func(_ []types.RoleAssignment, st base.State) (bool, error) {
+			parsedKey, err := crcystate.ParseStateKey(st.Key(), state.CredentialPrefix, 4)
+			if err != nil {
+				return false, err
+			}
+
+			tr, err := state.StateTemplateRolesStateValue(st)
+			if err != nil {
+				return false, err
+			}
+
+			if _, found := latest[parsedKey[2]]; !found {
+				templateIDs = append(templateIDs, parsedKey[2])
+			}
+			latest[parsedKey[2]] = tr
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "template roles by contract %s", contract)
+	}
+
+	var vs []templateRolesHalValue
+	for _, templateID := range templateIDs {
+		tr := latest[templateID]
+		if len(tr.Assignments) < 1 && !tr.Restricted {
+			continue
+		}
+
+		vs = append(vs, templateRolesHalValue{
+			TemplateID: templateID, Assignments: tr.Assignments, Restricted: tr.Restricted})
+	}
+
+	if len(vs) < 1 {
+		return nil, mitumutil.ErrNotFound.Errorf("template roles by contract %s", contract)
+	}
+
+	h, err := hd.combineURL(HandlerPathDIDRoles, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := currencydigest.NewBaseHal(
+		struct {
+			Roles []templateRolesHalValue `json:"roles"`
+		}{
+			Roles: vs,
+		}, currencydigest.NewHalLink(h, nil))
+
+	return hd.encoder.Marshal(hal)
+}
+
+func (hd *Handlers) handleOffer(w http.ResponseWriter, r *http.Request) {
+	cacheKey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	templateID, err, status := currencydigest.ParseRequest(w, r, "template_id")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	credentialID, err, status := currencydigest.ParseRequest(w, r, "credential_id")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleOfferInGroup(contract, templateID, credentialID)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Second*3)
+		}
+	}
+}
+
+type offerHalValue struct {
+	Credential types.Credential  `json:"credential"`
+	Offerer    base.Address      `json:"offerer"`
+	ExpiresAt  base.Height       `json:"expires_at"`
+	Status     types.OfferStatus `json:"status"`
+}
+
+func (hd *Handlers) handleOfferInGroup(contract, templateID, credentialID string) (interface{}, error) {
+	offer, err := Offer(hd.database, contract, templateID, credentialID)
+	switch {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "credential offer by contract %s, template %s, id %s", contract, templateID, credentialID)
+	case offer == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("credential offer by contract %s, template %s, id %s", contract, templateID, credentialID)
+	}
+
+	h, err := hd.combineURL(
+		HandlerPathDIDOffer,
+		"contract", contract,
+		"template_id", templateID,
+		"credential_id", credentialID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE the pending offer is expired when it can not be accepted in the
+	// next block.
+	hal := currencydigest.NewBaseHal(
+		offerHalValue{
+			Credential: offer.Credential,
+			Offerer:    offer.Offerer,
+			ExpiresAt:  offer.ExpiresAt,
+			Status:     offer.CurrentStatus(hd.database.LastBlock() + 1),
+		},
+		currencydigest.NewHalLink(h, nil),
+	)
+
+	return hd.encoder.Marshal(hal)
+}
+
+func (hd *Handlers) handleRequests(w http.ResponseWriter, r *http.Request) {
+	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
+	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
+	reverse := currencydigest.ParseBoolQuery(r.URL.Query().Get("reverse"))
+	requestStatus := currencydigest.ParseStringQuery(r.URL.Query().Get("status"))
+
+	if len(requestStatus) > 0 {
+		if err := types.RequestStatus(requestStatus).IsValid(nil); err != nil {
+			currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	cachekey := currencydigest.CacheKey(
+		r.URL.Path, currencydigest.StringOffsetQuery(offset),
+		currencydigest.StringBoolQuery("reverse", reverse),
+		stringStatusQuery(requestStatus),
+	)
+
+	contract, err, status := currencydigest.ParseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+
+		return
+	}
+
+	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleRequestsInGroup(contract, requestStatus, offset, reverse, limit)
+
+		return []interface{}{i, filled}, err
+	})
+
+	if err != nil {
+		hd.Log().Err(err).Str("Issuer", contract).Msg("failed to get credential requests")
+		currencydigest.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	var b []byte
+	var filled bool
+	{
+		l := v.([]interface{})
+		b = l[0].([]byte)
+		filled = l[1].(bool)
+	}
+
+	currencydigest.HTTP2WriteHalBytes(hd.encoder, w, b, http.StatusOK)
+
+	if !shared {
+		expire := hd.expireNotFilled
+		if len(offset) > 0 && filled {
+			expire = time.Minute
+		}
+
+		currencydigest.HTTP2WriteCache(w, cachekey, expire)
+	}
+}
+
+type requestHalValue struct {
+	RequestID    string              `json:"request_id"`
+	TemplateID   string              `json:"template_id"`
+	Holder       base.Address        `json:"holder"`
+	Value        string              `json:"value"`
+	Status       types.RequestStatus `json:"status"`
+	CredentialID string              `json:"credential_id,omitempty"`
+	Reason       string              `json:"reason,omitempty"`
+	Height       base.Height         `json:"height"`
+}
+
+func (hd *Handlers) handleRequestsInGroup(
+	contract, status string,
+	offset string,
+	reverse bool,
+	l int64,
+) ([]byte, bool, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("service-requests")
+	} else {
+		limit = l
+	}
+
+	var vas []currencydigest.Hal
+	if err := RequestsByService(
+		hd.database, contract, status, reverse, offset, limit,
+		func(rq state.RequestStateValue, st base.State) (bool, error) {
+			hal, err := hd.buildRequestHal(contract, rq, st)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, mitumutil.ErrNotFound.WithMessage(err, "credential requests by contract %s", contract)
+	} else if len(vas) < 1 {
+		return nil, false, mitumutil.ErrNotFound.Errorf("credential requests by contract %s", contract)
+	}
+
+	i, err := hd.buildRequestsHal(contract, status, vas, offset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.encoder.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) buildRequestHal(
+	contract string, rq state.RequestStateValue, st base.State,
+) (currencydigest.Hal, error) {
+	parsedKey, err := crcystate.ParseStateKey(st.Key(), state.CredentialPrefix, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := hd.combineURL(
+		HandlerPathDIDTemplate,
+		"contract", contract,
+		"template_id", rq.TemplateID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return currencydigest.NewBaseHal(
+		requestHalValue{
+			RequestID:    parsedKey[2],
+			TemplateID:   rq.TemplateID,
+			Holder:       rq.Holder,
+			Value:        rq.Value,
+			Status:       rq.Status,
+			CredentialID: rq.CredentialID,
+			Reason:       rq.Reason,
+			Height:       st.Height(),
+		},
+		currencydigest.NewHalLink(h, nil),
+	), nil
+}
+
+func (hd *Handlers) buildRequestsHal(
+	contract, status string,
+	vas []currencydigest.Hal,
+	offset string,
+	reverse bool,
+) (currencydigest.Hal, error) {
+	baseSelf, err := hd.combineURL(HandlerPathDIDRequests, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(status) > 0 {
+		baseSelf = currencydigest.AddQueryValue(baseSelf, stringStatusQuery(status))
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = currencydigest.AddQueryValue(self, currencydigest.StringOffsetQuery(offset))
+	}
+	if reverse {
+		self = currencydigest.AddQueryValue(self, currencydigest.StringBoolQuery("reverse", reverse))
+	}
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(vas, currencydigest.NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathDIDService, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("service", currencydigest.NewHalLink(h, nil))
+
+	var nextOffset string
+
+	if len(vas) > 0 {
+		va, ok := vas[len(vas)-1].Interface().(requestHalValue)
+		if !ok {
+			return nil, errors.Errorf("failed to build credential requests hal")
+		}
+		nextOffset = va.RequestID
+	}
+
+	if len(nextOffset) > 0 {
+		next := baseSelf
+		next = currencydigest.AddQueryValue(next, currencydigest.StringOffsetQuery(nextOffset))
+
+		if reverse {
+			next = currencydigest.AddQueryValue(next, currencydigest.StringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", currencydigest.NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink("reverse", currencydigest.NewHalLink(currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", !reverse)), nil))
+
+	return hal, nil
+}
+
+func stringStatusQuery(status string) string {
+	if len(status) < 1 {
+		return ""
+	}
+
+	return "status=" + status
+}
@@ -0,0 +1,158 @@
+package digest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// StatusListMinLength is the minimum length of bitstring in bits, which
+	// keeps the herd privacy of the status list.
+	StatusListMinLength     = 131072
+	StatusPurposeRevocation = "revocation"
+	StatusPurposeSuspension = "suspension"
+	VCTypeStatusListEntry   = "BitstringStatusListEntry"
+	VCTypeStatusListVC      = "BitstringStatusListCredential"
+	VCTypeStatusList        = "BitstringStatusList"
+)
+
+// Bitstring is the bitstring of W3C Bitstring Status List; the index 0 is the
+// left-most bit of the first byte.
+type Bitstring []byte
+
+func NewBitstring(length uint64) Bitstring {
+	if length < StatusListMinLength {
+		length = StatusListMinLength
+	}
+
+	return make(Bitstring, (length+7)/8)
+}
+
+// DecodeBitstring decodes the multibase encoded, base64url without padding of
+// GZIP compressed bitstring.
+func DecodeBitstring(encoded string) (Bitstring, error) {
+	if len(encoded) < 1 || encoded[0] != 'u' {
+		return nil, errors.Errorf("bitstring must be multibase base64url encoded")
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(encoded[1:])
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to decode bitstring")
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to decompress bitstring")
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+
+	d, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to decompress bitstring")
+	}
+
+	return Bitstring(d), nil
+}
+
+func (b Bitstring) Len() uint64 {
+	return uint64(len(b)) * 8
+}
+
+func (b Bitstring) Get(index uint64) bool {
+	if index >= b.Len() {
+		return false
+	}
+
+	return b[index/8]&(0x80>>(index%8)) != 0
+}
+
+// Set returns the bitstring with the bit of index; the bitstring grows by
+// StatusListMinLength when index is out of the length.
+func (b Bitstring) Set(index uint64, v bool) Bitstring {
+	if index >= b.Len() {
+		n := NewBitstring((index/StatusListMinLength + 1) * StatusListMinLength)
+		copy(n, b)
+		b = n
+	}
+
+	if v {
+		b[index/8] |= 0x80 >> (index % 8)
+	} else {
+		b[index/8] &^= 0x80 >> (index % 8)
+	}
+
+	return b
+}
+
+func (b Bitstring) Encode() (string, error) {
+	var buf bytes.Buffer
+
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(b); err != nil {
+		return "", errors.WithMessage(err, "failed to compress bitstring")
+	}
+
+	if err := w.Close(); err != nil {
+		return "", errors.WithMessage(err, "failed to compress bitstring")
+	}
+
+	return "u" + base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// StatusList keeps the revocation and suspension bitstrings of the credentials
+// of a template. Size is the number of indexes assigned to the credentials.
+type StatusList struct {
+	Contract   string
+	TemplateID string
+	Size       uint64
+	Revocation Bitstring
+	Suspension Bitstring
+}
+
+func NewStatusList(contract, templateID string) StatusList {
+	return StatusList{
+		Contract:   contract,
+		TemplateID: templateID,
+		Revocation: NewBitstring(StatusListMinLength),
+		Suspension: NewBitstring(StatusListMinLength),
+	}
+}
+
+// Assign returns the next index of the status list for a new credential.
+func (s *StatusList) Assign() uint64 {
+	index := s.Size
+	s.Size++
+
+	return index
+}
+
+// SetStatus returns the status list with the bits of index set by the status
+// of credential.
+func (s StatusList) SetStatus(index uint64, status types.CredentialStatus) StatusList {
+	// renounced credentials are revoked for verifiers as well.
+	s.Revocation = s.Revocation.Set(index, status.IsTerminated())
+	// pending credentials are not yet valid; verifiers see them as suspended
+	// until the auditors approve them.
+	s.Suspension = s.Suspension.Set(index,
+		status == types.CredentialStatusSuspended || status == types.CredentialStatusPending)
+
+	return s
+}
+
+func (s StatusList) Bitstring(purpose string) (Bitstring, error) {
+	switch purpose {
+	case StatusPurposeRevocation:
+		return s.Revocation, nil
+	case StatusPurposeSuspension:
+		return s.Suspension, nil
+	default:
+		return nil, errors.Errorf("unknown status purpose, %q", purpose)
+	}
+}
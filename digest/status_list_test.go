@@ -0,0 +1,172 @@
+package digest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+)
+
+// statusListSpecExample is the encoded list of the example of W3C Bitstring
+// Status List v1.0, which is the GZIP compressed bitstring of 131072 zero bits.
+const statusListSpecExample = "uH4sIAAAAAAAAA-3BMQEAAADCoPVPbQwfoAAAAAAAAAAAAAAAAAAAAIC3AYbSVKsAQAAA"
+
+func TestBitstringDecodeSpecExample(t *testing.T) {
+	b, err := DecodeBitstring(statusListSpecExample)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b.Len() != StatusListMinLength {
+		t.Fatalf("length, %d != %d", b.Len(), StatusListMinLength)
+	}
+
+	if !bytes.Equal(b, NewBitstring(StatusListMinLength)) {
+		t.Fatal("spec example is not all zero bits")
+	}
+}
+
+func TestBitstringBitOrder(t *testing.T) {
+	b := NewBitstring(0)
+
+	if b.Len() != StatusListMinLength {
+		t.Fatalf("length under minimum, %d != %d", b.Len(), StatusListMinLength)
+	}
+
+	// NOTE the index 0 is the left-most bit of the first byte.
+	b = b.Set(0, true).Set(7, true).Set(9, true)
+
+	if !bytes.Equal(b[:2], []byte{0x81, 0x40}) {
+		t.Fatalf("bits, %x != 8140", b[:2])
+	}
+
+	for index, v := range map[uint64]bool{0: true, 1: false, 7: true, 8: false, 9: true} {
+		if b.Get(index) != v {
+			t.Fatalf("bit %d, %v != %v", index, b.Get(index), v)
+		}
+	}
+
+	b = b.Set(7, false)
+	if b[0] != 0x80 {
+		t.Fatalf("unset bit, %x != 80", b[0])
+	}
+
+	if b.Get(b.Len()) {
+		t.Fatal("bit out of length is set")
+	}
+}
+
+func TestBitstringGrow(t *testing.T) {
+	b := NewBitstring(StatusListMinLength).Set(StatusListMinLength, true)
+
+	if b.Len() != StatusListMinLength*2 {
+		t.Fatalf("grown length, %d != %d", b.Len(), StatusListMinLength*2)
+	}
+
+	if !b.Get(StatusListMinLength) || b.Get(StatusListMinLength-1) {
+		t.Fatal("wrong bits of grown bitstring")
+	}
+}
+
+func TestBitstringEncodeRoundTrip(t *testing.T) {
+	b := NewBitstring(StatusListMinLength).Set(1, true).Set(94567, true).Set(StatusListMinLength-1, true)
+
+	encoded, err := b.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// multibase base64url without padding
+	if encoded[0] != 'u' {
+		t.Fatalf("multibase prefix, %q", encoded[0])
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(encoded[1:])
+	if err != nil {
+		t.Fatalf("not base64url without padding; %v", err)
+	}
+
+	// GZIP magic number
+	if !bytes.HasPrefix(raw, []byte{0x1f, 0x8b}) {
+		t.Fatalf("not gzip compressed, %x", raw[:2])
+	}
+
+	d, err := DecodeBitstring(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(b, d) {
+		t.Fatal("decoded bitstring does not match")
+	}
+
+	for _, s := range []string{"", "zabc", "u!!", "u" + base64.RawURLEncoding.EncodeToString([]byte("not gzip"))} {
+		if _, err := DecodeBitstring(s); err == nil {
+			t.Fatalf("invalid bitstring decoded, %q", s)
+		}
+	}
+}
+
+func TestStatusListAssign(t *testing.T) {
+	sl := NewStatusList("contract", "template")
+
+	for i := uint64(0); i < 3; i++ {
+		if index := sl.Assign(); index != i {
+			t.Fatalf("assigned index, %d != %d", index, i)
+		}
+	}
+
+	if sl.Size != 3 {
+		t.Fatalf("size, %d != 3", sl.Size)
+	}
+}
+
+func TestStatusListSetStatus(t *testing.T) {
+	cases := []struct {
+		status    types.CredentialStatus
+		revoked   bool
+		suspended bool
+	}{
+		{types.CredentialStatusActive, false, false},
+		{types.CredentialStatusRevoked, true, false},
+		{types.CredentialStatusRenounced, true, false},
+		{types.CredentialStatusSuspended, false, true},
+		{types.CredentialStatusPending, false, true},
+	}
+
+	sl := NewStatusList("contract", "template")
+
+	for i, c := range cases {
+		index := sl.Assign()
+		sl = sl.SetStatus(index, c.status)
+
+		if index != uint64(i) {
+			t.Fatalf("%s: assigned index, %d != %d", c.status, index, i)
+		}
+
+		if sl.Revocation.Get(index) != c.revoked {
+			t.Fatalf("%s: revocation bit, %v != %v", c.status, sl.Revocation.Get(index), c.revoked)
+		}
+
+		if sl.Suspension.Get(index) != c.suspended {
+			t.Fatalf("%s: suspension bit, %v != %v", c.status, sl.Suspension.Get(index), c.suspended)
+		}
+	}
+
+	// reinstated credential clears the suspension bit of the same index.
+	sl = sl.SetStatus(3, types.CredentialStatusActive)
+	if sl.Suspension.Get(3) || sl.Revocation.Get(3) {
+		t.Fatal("bits of reinstated credential are not cleared")
+	}
+
+	for _, purpose := range []string{StatusPurposeRevocation, StatusPurposeSuspension} {
+		if _, err := sl.Bitstring(purpose); err != nil {
+			t.Fatalf("purpose %s; %v", purpose, err)
+		}
+	}
+
+	if _, err := sl.Bitstring("refresh"); err == nil {
+		t.Fatal("unknown purpose accepted")
+	}
+}
@@ -0,0 +1,183 @@
+package digest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+)
+
+const (
+	VCMimetype          = "application/vc"
+	VCContextV2         = "https://www.w3.org/ns/credentials/v2"
+	VCTypeCredential    = "VerifiableCredential"
+	VCTypeStatusEntry   = "CredentialStatusEntry"
+	VCDefaultSubjectKey = "value"
+)
+
+// VerifiableCredential is the W3C Verifiable Credentials Data Model 2.0
+// representation of the stored credential. It has no proof; the credential is
+// secured by the chain state which the digest API serves.
+//
+// The terms which are not defined in the base context, like the template type
+// and the subject key, are expanded by the issuer-dependent @vocab of the base
+// context.
+type VerifiableCredential struct {
+	Context           []string               `json:"@context"`
+	ID                string                 `json:"id"`
+	Type              []string               `json:"type"`
+	Name              string                 `json:"name,omitempty"`
+	Description       string                 `json:"description,omitempty"`
+	Issuer            string                 `json:"issuer"`
+	ValidFrom         string                 `json:"validFrom,omitempty"`
+	ValidUntil        string                 `json:"validUntil,omitempty"`
+	CredentialSubject map[string]interface{} `json:"credentialSubject"`
+	CredentialStatus  interface{}            `json:"credentialStatus,omitempty"`
+}
+
+// NewVerifiableCredential builds VerifiableCredential; the template maps to
+// type, the service contract to issuer, the holder DID to credentialSubject.id
+// and the credential status to credentialStatus. issuer and id are the absolute
+// URLs of the credential service and the credential. When the credential has
+// the index of status list, credentialStatus refers the status lists of
+// template, statusList is the URL of status list without purpose.
+func NewVerifiableCredential(
+	issuer, id string,
+	cv state.CredentialStateValue,
+	template *types.Template,
+	statusList string,
+	statusIndex *uint64,
+) VerifiableCredential {
+	credential := cv.Credential
+
+	vc := VerifiableCredential{
+		Context:    []string{VCContextV2},
+		ID:         id,
+		Type:       []string{VCTypeCredential, credential.TemplateID()},
+		Issuer:     issuer,
+		ValidFrom:  vcTime(credential.ValidFrom()),
+		ValidUntil: vcTime(credential.ValidUntil()),
+	}
+
+	subjectKey := VCDefaultSubjectKey
+	if template != nil {
+		vc.Name = template.DisplayName()
+		vc.Description = template.Description()
+
+		if len(template.SubjectKey()) > 0 && template.SubjectKey() != "id" {
+			subjectKey = template.SubjectKey()
+		}
+	}
+
+	subject := map[string]interface{}{}
+	if len(credential.DID()) > 0 {
+		subject["id"] = credential.DID()
+	}
+	subject[subjectKey] = vcClaim(credential.Value())
+	vc.CredentialSubject = subject
+
+	if statusIndex != nil {
+		vc.CredentialStatus = []map[string]interface{}{
+			newStatusListEntry(statusList, StatusPurposeRevocation, *statusIndex),
+			newStatusListEntry(statusList, StatusPurposeSuspension, *statusIndex),
+		}
+
+		return vc
+	}
+
+	status := map[string]interface{}{
+		"id":     id + "#status",
+		"type":   VCTypeStatusEntry,
+		"status": cv.Status.String(),
+	}
+	if cv.Revocation != nil {
+		status["revocation"] = cv.Revocation
+	}
+	vc.CredentialStatus = status
+
+	return vc
+}
+
+func newStatusListEntry(statusList, purpose string, index uint64) map[string]interface{} {
+	u := statusList + "/" + purpose
+
+	return map[string]interface{}{
+		"id":                   u + "#" + strconv.FormatUint(index, 10),
+		"type":                 VCTypeStatusListEntry,
+		"statusPurpose":        purpose,
+		"statusListIndex":      strconv.FormatUint(index, 10),
+		"statusListCredential": u,
+	}
+}
+
+// NewStatusListCredential builds the BitstringStatusListCredential of purpose.
+// id is the absolute URL of status list.
+func NewStatusListCredential(issuer, id, purpose string, sl StatusList) (VerifiableCredential, error) {
+	b, err := sl.Bitstring(purpose)
+	if err != nil {
+		return VerifiableCredential{}, err
+	}
+
+	encoded, err := b.Encode()
+	if err != nil {
+		return VerifiableCredential{}, err
+	}
+
+	return VerifiableCredential{
+		Context: []string{VCContextV2},
+		ID:      id,
+		Type:    []string{VCTypeCredential, VCTypeStatusListVC},
+		Issuer:  issuer,
+		CredentialSubject: map[string]interface{}{
+			"id":            id + "#list",
+			"type":          VCTypeStatusList,
+			"statusPurpose": purpose,
+			"encodedList":   encoded,
+		},
+	}, nil
+}
+
+func vcTime(t uint64) string {
+	return time.Unix(int64(t), 0).UTC().Format(time.RFC3339)
+}
+
+// vcClaim returns the credential value as JSON value if value is valid JSON,
+// otherwise as string.
+func vcClaim(value string) interface{} {
+	d := json.NewDecoder(bytes.NewReader([]byte(value)))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil || d.More() {
+		return value
+	}
+
+	return v
+}
+
+func requestBaseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	if p := r.Header.Get("X-Forwarded-Proto"); len(p) > 0 {
+		scheme = p
+	}
+
+	return scheme + "://" + r.Host
+}
+
+func HTTP2WriteVCBytes(w http.ResponseWriter, b []byte, status int) {
+	w.Header().Set("Content-Type", VCMimetype)
+
+	if status != http.StatusOK {
+		w.WriteHeader(status)
+	}
+
+	_, _ = w.Write(b)
+}
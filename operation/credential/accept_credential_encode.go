@@ -0,0 +1,31 @@
+package credential
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *AcceptCredentialFact) unpack(enc encoder.Encoder,
+	sAdr, cAdr, tmplID, crdID, cid string,
+) error {
+	fact.templateID = tmplID
+	fact.credentialID = crdID
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type AcceptCredentialFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner        base.Address             `json:"sender"`
+	Contract     base.Address             `json:"contract"`
+	TemplateID   string                   `json:"template_id"`
+	CredentialID string                   `json:"credential_id"`
+	Currency     currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact AcceptCredentialFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AcceptCredentialFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		CredentialID:          fact.credentialID,
+		Currency:              fact.currency,
+	})
+}
+
+type AcceptCredentialFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner        string `json:"sender"`
+	Contract     string `json:"contract"`
+	TemplateID   string `json:"template_id"`
+	CredentialID string `json:"credential_id"`
+	Currency     string `json:"currency"`
+}
+
+func (fact *AcceptCredentialFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf AcceptCredentialFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.TemplateID,
+		uf.CredentialID,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type AcceptCredentialMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op AcceptCredential) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AcceptCredentialMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *AcceptCredential) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,276 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var acceptCredentialProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AcceptCredentialProcessor)
+	},
+}
+
+func (AcceptCredential) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type AcceptCredentialProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewAcceptCredentialProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new AcceptCredentialProcessor")
+
+		nopp := acceptCredentialProcessorPool.Get()
+		opp, ok := nopp.(*AcceptCredentialProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected AcceptCredentialProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *AcceptCredentialProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(AcceptCredentialFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", AcceptCredentialFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if _, _, aErr, cErr := currencystate.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: sender %v is contract account", cErr, fact.Sender())), nil
+	}
+
+	_, cSt, aErr, cErr := currencystate.ExistsCAccount(fact.Contract(), "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", cErr)), nil
+	}
+
+	offer, err := checkOpenOffer(
+		fact.Sender(), fact.Contract(), fact.TemplateID(), fact.CredentialID(), opp.Height(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	template, err := credentialTemplate(fact.Contract(), offer.Credential, getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if template.Deprecated() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMValueInvalid).
+				Errorf("deprecated template %v", fact.TemplateID())), nil
+	}
+
+	// NOTE the offerer, the template and the prerequisites of holder could be
+	// changed after the offer, so the offer is checked again as the issuance.
+	if err := template.CheckServiceHeight(opp.Height()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkTemplateRole(
+		cSt, offer.Offerer, fact.Contract(), fact.TemplateID(), types.RoleIssuer, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("offerer %v; %v", offer.Offerer, err)), nil
+	}
+
+	if err := checkPrerequisites(
+		fact.Contract(), fact.Sender(), template, offer.Credential.ValidFrom(), offer.Credential.ValidUntil(),
+		getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkCredentialIssuable(
+		fact.Contract(), offer.Credential.TemplateContract(), fact.TemplateID(), fact.CredentialID(),
+		getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkIssuanceLimits(
+		fact.Contract(), fact.Sender(), template, offer.Credential.Value(), "", nil, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkServiceOpen(fact.Contract(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AcceptCredentialProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process AcceptCredential")
+
+	fact, ok := op.Fact().(AcceptCredentialFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected AcceptCredentialFact, not %T", op.Fact())
+	}
+
+	offer, err := checkOpenOffer(
+		fact.Sender(), fact.Contract(), fact.TemplateID(), fact.CredentialID(), opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	template, err := credentialTemplate(fact.Contract(), offer.Credential, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := checkCredentialIssuable(
+		fact.Contract(), offer.Credential.TemplateContract(), fact.TemplateID(), fact.CredentialID(),
+		getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	stats, err := newHolderStats(fact.Contract(), opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"credential design value not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	templateOwner := fact.Contract()
+	if tc := offer.Credential.TemplateContract(); tc != nil {
+		templateOwner = tc
+	}
+
+	quotas := newIssuanceQuotas(opp.Height())
+	if err := quotas.add(fact.Contract(), templateOwner, fact.TemplateID(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	ref := types.NewCredentialRef(fact.TemplateID(), fact.CredentialID())
+	if err := stats.add(fact.Sender(), ref, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if template.UniqueValue() {
+		if err := stats.addValue(ref, offer.Credential.Value(), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+	}
+
+	// NOTE the credential of template with auditors waits for the approvals
+	// of auditors by AuditCredential.
+	status := types.CredentialStatusActive
+	if template.AuditRequired() {
+		status = types.CredentialStatusPending
+	}
+
+	sts := []base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			state.StateKeyCredential(fact.Contract(), fact.TemplateID(), fact.CredentialID()),
+			state.NewCredentialStateValue(offer.Credential, status),
+		),
+		currencystate.NewStateMergeValue(
+			state.StateKeyHolderDID(fact.Contract(), fact.Sender()),
+			state.NewHolderDIDStateValue(offer.Credential.DID()),
+		),
+		currencystate.NewStateMergeValue(
+			state.StateKeyOffer(fact.Contract(), fact.TemplateID(), fact.CredentialID()),
+			offer.SetStatus(types.OfferStatusAccepted),
+		),
+	}
+
+	statSts, err := stats.states()
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+	sts = append(sts, statSts...)
+	sts = append(sts, quotas.states()...)
+
+	feeSts, rErr, err := processCredentialItemsFee(getStateFunc, fact.Sender(), []CredentialItem{fact})
+	if rErr != nil || err != nil {
+		return nil, rErr, err
+	}
+
+	return append(sts, feeSts...), nil, nil
+}
+
+func (opp *AcceptCredentialProcessor) Close() error {
+	acceptCredentialProcessorPool.Put(opp)
+
+	return nil
+}
@@ -20,19 +20,30 @@ var (
 
 type AddTemplateFact struct {
 	base.BaseFact
-	sender         base.Address
-	contract       base.Address
-	templateID     string
-	templateName   string
-	serviceDate    types.Date
-	expirationDate types.Date
-	templateShare  types.Bool
-	multiAudit     types.Bool
-	displayName    string
-	subjectKey     string
-	description    string
-	creator        base.Address
-	currency       crcytypes.CurrencyID
+	sender           base.Address
+	contract         base.Address
+	templateID       string
+	templateName     string
+	serviceDate      types.Date
+	expirationDate   types.Date
+	templateShare    types.Bool
+	multiAudit       types.Bool
+	displayName      string
+	subjectKey       string
+	description      string
+	creator          base.Address
+	schema           string
+	schemaHash       string
+	auditors         []base.Address
+	auditThreshold   uint64
+	offerLifetime    uint64
+	prerequisites    []string
+	cascade          types.Bool
+	maxPerHolder     uint64
+	uniqueValue      types.Bool
+	serviceHeight    base.Height
+	expirationHeight base.Height
+	currency         crcytypes.CurrencyID
 }
 
 func NewAddTemplateFact(
@@ -49,24 +60,46 @@ func NewAddTemplateFact(
 	subjectKey string,
 	description string,
 	creator base.Address,
+	schema string,
+	schemaHash string,
+	auditors []base.Address,
+	auditThreshold uint64,
+	offerLifetime uint64,
+	prerequisites []string,
+	cascade types.Bool,
+	maxPerHolder uint64,
+	uniqueValue types.Bool,
+	serviceHeight base.Height,
+	expirationHeight base.Height,
 	currency crcytypes.CurrencyID,
 ) AddTemplateFact {
 	bf := base.NewBaseFact(AddTemplateFactHint, token)
 	fact := AddTemplateFact{
-		BaseFact:       bf,
-		sender:         sender,
-		contract:       contract,
-		templateID:     templateID,
-		templateName:   templateName,
-		serviceDate:    serviceDate,
-		expirationDate: expirationDate,
-		templateShare:  templateShare,
-		multiAudit:     multiAudit,
-		displayName:    displayName,
-		subjectKey:     subjectKey,
-		description:    description,
-		creator:        creator,
-		currency:       currency,
+		BaseFact:         bf,
+		sender:           sender,
+		contract:         contract,
+		templateID:       templateID,
+		templateName:     templateName,
+		serviceDate:      serviceDate,
+		expirationDate:   expirationDate,
+		templateShare:    templateShare,
+		multiAudit:       multiAudit,
+		displayName:      displayName,
+		subjectKey:       subjectKey,
+		description:      description,
+		creator:          creator,
+		schema:           schema,
+		schemaHash:       schemaHash,
+		auditors:         auditors,
+		auditThreshold:   auditThreshold,
+		offerLifetime:    offerLifetime,
+		prerequisites:    prerequisites,
+		cascade:          cascade,
+		maxPerHolder:     maxPerHolder,
+		uniqueValue:      uniqueValue,
+		serviceHeight:    serviceHeight,
+		expirationHeight: expirationHeight,
+		currency:         currency,
 	}
 	fact.SetHash(fact.GenerateHash())
 
@@ -82,6 +115,43 @@ func (fact AddTemplateFact) GenerateHash() util.Hash {
 }
 
 func (fact AddTemplateFact) Bytes() []byte {
+	var ab []byte
+	if len(fact.auditors) > 0 {
+		bs := make([][]byte, len(fact.auditors)+1)
+		for i := range fact.auditors {
+			bs[i] = fact.auditors[i].Bytes()
+		}
+		bs[len(fact.auditors)] = util.Uint64ToBytes(fact.auditThreshold)
+
+		ab = util.ConcatBytesSlice(bs...)
+	}
+
+	var ob []byte
+	if fact.offerLifetime > 0 {
+		ob = util.Uint64ToBytes(fact.offerLifetime)
+	}
+
+	var pb []byte
+	if len(fact.prerequisites) > 0 {
+		bs := make([][]byte, len(fact.prerequisites)+1)
+		for i := range fact.prerequisites {
+			bs[i] = []byte(fact.prerequisites[i])
+		}
+		bs[len(fact.prerequisites)] = fact.cascade.Bytes()
+
+		pb = util.ConcatBytesSlice(bs...)
+	}
+
+	var lb []byte
+	if fact.maxPerHolder > 0 || fact.uniqueValue {
+		lb = util.ConcatBytesSlice(util.Uint64ToBytes(fact.maxPerHolder), fact.uniqueValue.Bytes())
+	}
+
+	var hb []byte
+	if fact.serviceHeight > 0 || fact.expirationHeight > 0 {
+		hb = util.ConcatBytesSlice(fact.serviceHeight.Bytes(), fact.expirationHeight.Bytes())
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.sender.Bytes(),
@@ -96,6 +166,13 @@ func (fact AddTemplateFact) Bytes() []byte {
 		[]byte(fact.subjectKey),
 		[]byte(fact.description),
 		fact.creator.Bytes(),
+		[]byte(fact.schema),
+		[]byte(fact.schemaHash),
+		ab,
+		ob,
+		pb,
+		lb,
+		hb,
 		fact.currency.Bytes(),
 	)
 }
@@ -149,7 +226,7 @@ func (fact AddTemplateFact) IsValid(b []byte) error {
 		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(err))
 	}
 
-	expire, err := fact.serviceDate.Parse()
+	expire, err := fact.expirationDate.Parse()
 	if err != nil {
 		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(err))
 	}
@@ -158,6 +235,32 @@ func (fact AddTemplateFact) IsValid(b []byte) error {
 		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("expire date <= service date, %s <= %s", fact.expirationDate, fact.serviceDate)))
 	}
 
+	if err := types.IsValidTemplateSchema(fact.schema, fact.schemaHash); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := types.IsValidTemplateAuditors(fact.multiAudit, fact.auditors, fact.auditThreshold); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	for i := range fact.auditors {
+		if fact.auditors[i].Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("auditor %v is same with contract account", fact.auditors[i])))
+		}
+	}
+
+	if err := types.IsValidTemplatePrerequisites(fact.templateID, fact.prerequisites, fact.cascade); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.serviceHeight < 0 || fact.expirationHeight < 0 {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("negative service height or expiration height")))
+	}
+
+	if err := types.IsValidTemplateServiceHeights(fact.serviceHeight, fact.expirationHeight); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
 	if err := common.IsValidOperationFact(fact, b); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
 	}
@@ -217,6 +320,50 @@ func (fact AddTemplateFact) Creator() base.Address {
 	return fact.creator
 }
 
+func (fact AddTemplateFact) Schema() string {
+	return fact.schema
+}
+
+func (fact AddTemplateFact) SchemaHash() string {
+	return fact.schemaHash
+}
+
+func (fact AddTemplateFact) Auditors() []base.Address {
+	return fact.auditors
+}
+
+func (fact AddTemplateFact) AuditThreshold() uint64 {
+	return fact.auditThreshold
+}
+
+func (fact AddTemplateFact) OfferLifetime() uint64 {
+	return fact.offerLifetime
+}
+
+func (fact AddTemplateFact) Prerequisites() []string {
+	return fact.prerequisites
+}
+
+func (fact AddTemplateFact) Cascade() types.Bool {
+	return fact.cascade
+}
+
+func (fact AddTemplateFact) MaxPerHolder() uint64 {
+	return fact.maxPerHolder
+}
+
+func (fact AddTemplateFact) UniqueValue() types.Bool {
+	return fact.uniqueValue
+}
+
+func (fact AddTemplateFact) ServiceHeight() base.Height {
+	return fact.serviceHeight
+}
+
+func (fact AddTemplateFact) ExpirationHeight() base.Height {
+	return fact.expirationHeight
+}
+
 func (fact AddTemplateFact) Currency() crcytypes.CurrencyID {
 	return fact.currency
 }
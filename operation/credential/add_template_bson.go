@@ -5,6 +5,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"github.com/ProtoconNet/mitum2/util/valuehash"
@@ -13,41 +14,63 @@ import (
 func (fact AddTemplateFact) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":           fact.Hint().String(),
-			"sender":          fact.sender,
-			"contract":        fact.contract,
-			"template_id":     fact.templateID,
-			"template_name":   fact.templateName,
-			"service_date":    fact.serviceDate,
-			"expiration_date": fact.expirationDate,
-			"template_share":  fact.templateShare,
-			"multi_audit":     fact.multiAudit,
-			"display_name":    fact.displayName,
-			"subject_key":     fact.subjectKey,
-			"description":     fact.description,
-			"creator":         fact.creator,
-			"currency":        fact.currency,
-			"hash":            fact.BaseFact.Hash().String(),
-			"token":           fact.BaseFact.Token(),
+			"_hint":             fact.Hint().String(),
+			"sender":            fact.sender,
+			"contract":          fact.contract,
+			"template_id":       fact.templateID,
+			"template_name":     fact.templateName,
+			"service_date":      fact.serviceDate,
+			"expiration_date":   fact.expirationDate,
+			"template_share":    fact.templateShare,
+			"multi_audit":       fact.multiAudit,
+			"display_name":      fact.displayName,
+			"subject_key":       fact.subjectKey,
+			"description":       fact.description,
+			"creator":           fact.creator,
+			"schema":            fact.schema,
+			"schema_hash":       fact.schemaHash,
+			"auditors":          fact.auditors,
+			"audit_threshold":   fact.auditThreshold,
+			"offer_lifetime":    fact.offerLifetime,
+			"prerequisites":     fact.prerequisites,
+			"cascade":           fact.cascade,
+			"max_per_holder":    fact.maxPerHolder,
+			"unique_value":      fact.uniqueValue,
+			"service_height":    fact.serviceHeight,
+			"expiration_height": fact.expirationHeight,
+			"currency":          fact.currency,
+			"hash":              fact.BaseFact.Hash().String(),
+			"token":             fact.BaseFact.Token(),
 		},
 	)
 }
 
 type AddTemplateFactBSONUnmarshaler struct {
-	Hint           string `bson:"_hint"`
-	Sender         string `bson:"sender"`
-	Contract       string `bson:"contract"`
-	TemplateID     string `bson:"template_id"`
-	TemplateName   string `bson:"template_name"`
-	ServiceDate    string `bson:"service_date"`
-	ExpirationDate string `bson:"expiration_date"`
-	TemplateShare  bool   `bson:"template_share"`
-	MultiAudit     bool   `bson:"multi_audit"`
-	DisplayName    string `bson:"display_name"`
-	SubjectKey     string `bson:"subject_key"`
-	Description    string `bson:"description"`
-	Creator        string `bson:"creator"`
-	Currency       string `bson:"currency"`
+	Hint             string      `bson:"_hint"`
+	Sender           string      `bson:"sender"`
+	Contract         string      `bson:"contract"`
+	TemplateID       string      `bson:"template_id"`
+	TemplateName     string      `bson:"template_name"`
+	ServiceDate      string      `bson:"service_date"`
+	ExpirationDate   string      `bson:"expiration_date"`
+	TemplateShare    bool        `bson:"template_share"`
+	MultiAudit       bool        `bson:"multi_audit"`
+	DisplayName      string      `bson:"display_name"`
+	SubjectKey       string      `bson:"subject_key"`
+	Description      string      `bson:"description"`
+	Creator          string      `bson:"creator"`
+	Schema           string      `bson:"schema"`
+	SchemaHash       string      `bson:"schema_hash"`
+	Auditors         []string    `bson:"auditors"`
+	AuditThreshold   uint64      `bson:"audit_threshold"`
+	OfferLifetime    uint64      `bson:"offer_lifetime"`
+	Prerequisites    []string    `bson:"prerequisites"`
+	Cascade          bool        `bson:"cascade"`
+	MaxPerHolder     uint64      `bson:"max_per_holder"`
+	UniqueValue      bool        `bson:"unique_value"`
+	ServiceHeight    base.Height `bson:"service_height"`
+	ExpirationHeight base.Height `bson:"expiration_height"`
+	Currency         string      `bson:"currency"`
 }
 
 func (fact *AddTemplateFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -84,6 +107,17 @@ func (fact *AddTemplateFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		uf.SubjectKey,
 		uf.Description,
 		uf.Creator,
+		uf.Schema,
+		uf.SchemaHash,
+		uf.Auditors,
+		uf.AuditThreshold,
+		uf.OfferLifetime,
+		uf.Prerequisites,
+		uf.Cascade,
+		uf.MaxPerHolder,
+		uf.UniqueValue,
+		uf.ServiceHeight,
+		uf.ExpirationHeight,
 		uf.Currency)
 }
 
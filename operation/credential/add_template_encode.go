@@ -11,7 +11,14 @@ func (fact *AddTemplateFact) unpack(enc encoder.Encoder,
 	sAdr, cAdr, tmplID string,
 	tmplName, svcDate, expDate string,
 	tmplShr, ma bool,
-	dpName, subjKey, desc, crAdr, cid string,
+	dpName, subjKey, desc, crAdr string,
+	schema, schemaHash string,
+	auditors []string, auditThreshold uint64,
+	offerLifetime uint64,
+	prerequisites []string, cascade bool,
+	maxPerHolder uint64, uniqueValue bool,
+	serviceHeight, expirationHeight base.Height,
+	cid string,
 ) error {
 	fact.templateName = tmplName
 	fact.serviceDate = types.Date(svcDate)
@@ -21,6 +28,15 @@ func (fact *AddTemplateFact) unpack(enc encoder.Encoder,
 	fact.displayName = dpName
 	fact.subjectKey = subjKey
 	fact.description = desc
+	fact.schema = schema
+	fact.schemaHash = schemaHash
+	fact.auditThreshold = auditThreshold
+	fact.offerLifetime = offerLifetime
+	fact.cascade = types.Bool(cascade)
+	fact.maxPerHolder = maxPerHolder
+	fact.uniqueValue = types.Bool(uniqueValue)
+	fact.serviceHeight = serviceHeight
+	fact.expirationHeight = expirationHeight
 	fact.currency = currencytypes.CurrencyID(cid)
 	fact.templateID = tmplID
 
@@ -45,5 +61,20 @@ func (fact *AddTemplateFact) unpack(enc encoder.Encoder,
 		fact.creator = a
 	}
 
+	if len(prerequisites) > 0 {
+		fact.prerequisites = prerequisites
+	}
+
+	if len(auditors) > 0 {
+		fact.auditors = make([]base.Address, len(auditors))
+		for i := range auditors {
+			a, err := base.DecodeAddress(auditors[i], enc)
+			if err != nil {
+				return err
+			}
+			fact.auditors[i] = a
+		}
+	}
+
 	return nil
 }
@@ -11,19 +11,30 @@ import (
 
 type AddTemplateFactJSONMarshaler struct {
 	base.BaseFactJSONMarshaler
-	Owner          base.Address             `json:"sender"`
-	Contract       base.Address             `json:"contract"`
-	TemplateID     string                   `json:"template_id"`
-	TemplateName   string                   `json:"template_name"`
-	ServiceDate    types.Date               `json:"service_date"`
-	ExpirationDate types.Date               `json:"expiration_date"`
-	TemplateShare  types.Bool               `json:"template_share"`
-	MultiAudit     types.Bool               `json:"multi_audit"`
-	DisplayName    string                   `json:"display_name"`
-	SubjectKey     string                   `json:"subject_key"`
-	Description    string                   `json:"description"`
-	Creator        base.Address             `json:"creator"`
-	Currency       currencytypes.CurrencyID `json:"currency"`
+	Owner            base.Address             `json:"sender"`
+	Contract         base.Address             `json:"contract"`
+	TemplateID       string                   `json:"template_id"`
+	TemplateName     string                   `json:"template_name"`
+	ServiceDate      types.Date               `json:"service_date"`
+	ExpirationDate   types.Date               `json:"expiration_date"`
+	TemplateShare    types.Bool               `json:"template_share"`
+	MultiAudit       types.Bool               `json:"multi_audit"`
+	DisplayName      string                   `json:"display_name"`
+	SubjectKey       string                   `json:"subject_key"`
+	Description      string                   `json:"description"`
+	Creator          base.Address             `json:"creator"`
+	Schema           string                   `json:"schema,omitempty"`
+	SchemaHash       string                   `json:"schema_hash,omitempty"`
+	Auditors         []base.Address           `json:"auditors,omitempty"`
+	AuditThreshold   uint64                   `json:"audit_threshold,omitempty"`
+	OfferLifetime    uint64                   `json:"offer_lifetime,omitempty"`
+	Prerequisites    []string                 `json:"prerequisites,omitempty"`
+	Cascade          types.Bool               `json:"cascade,omitempty"`
+	MaxPerHolder     uint64                   `json:"max_per_holder,omitempty"`
+	UniqueValue      types.Bool               `json:"unique_value,omitempty"`
+	ServiceHeight    base.Height              `json:"service_height,omitempty"`
+	ExpirationHeight base.Height              `json:"expiration_height,omitempty"`
+	Currency         currencytypes.CurrencyID `json:"currency"`
 }
 
 func (fact AddTemplateFact) MarshalJSON() ([]byte, error) {
@@ -41,25 +52,47 @@ func (fact AddTemplateFact) MarshalJSON() ([]byte, error) {
 		SubjectKey:            fact.subjectKey,
 		Description:           fact.description,
 		Creator:               fact.creator,
+		Schema:                fact.schema,
+		SchemaHash:            fact.schemaHash,
+		Auditors:              fact.auditors,
+		AuditThreshold:        fact.auditThreshold,
+		OfferLifetime:         fact.offerLifetime,
+		Prerequisites:         fact.prerequisites,
+		Cascade:               fact.cascade,
+		MaxPerHolder:          fact.maxPerHolder,
+		UniqueValue:           fact.uniqueValue,
+		ServiceHeight:         fact.serviceHeight,
+		ExpirationHeight:      fact.expirationHeight,
 		Currency:              fact.currency,
 	})
 }
 
 type AddTemplateFactJSONUnMarshaler struct {
 	base.BaseFactJSONUnmarshaler
-	Owner          string `json:"sender"`
-	Contract       string `json:"contract"`
-	TemplateID     string `json:"template_id"`
-	TemplateName   string `json:"template_name"`
-	ServiceDate    string `json:"service_date"`
-	ExpirationDate string `json:"expiration_date"`
-	TemplateShare  bool   `json:"template_share"`
-	MultiAudit     bool   `json:"multi_audit"`
-	DisplayName    string `json:"display_name"`
-	SubjectKey     string `json:"subject_key"`
-	Description    string `json:"description"`
-	Creator        string `json:"creator"`
-	Currency       string `json:"currency"`
+	Owner            string      `json:"sender"`
+	Contract         string      `json:"contract"`
+	TemplateID       string      `json:"template_id"`
+	TemplateName     string      `json:"template_name"`
+	ServiceDate      string      `json:"service_date"`
+	ExpirationDate   string      `json:"expiration_date"`
+	TemplateShare    bool        `json:"template_share"`
+	MultiAudit       bool        `json:"multi_audit"`
+	DisplayName      string      `json:"display_name"`
+	SubjectKey       string      `json:"subject_key"`
+	Description      string      `json:"description"`
+	Creator          string      `json:"creator"`
+	Schema           string      `json:"schema"`
+	SchemaHash       string      `json:"schema_hash"`
+	Auditors         []string    `json:"auditors"`
+	AuditThreshold   uint64      `json:"audit_threshold"`
+	OfferLifetime    uint64      `json:"offer_lifetime"`
+	Prerequisites    []string    `json:"prerequisites"`
+	Cascade          bool        `json:"cascade"`
+	MaxPerHolder     uint64      `json:"max_per_holder"`
+	UniqueValue      bool        `json:"unique_value"`
+	ServiceHeight    base.Height `json:"service_height"`
+	ExpirationHeight base.Height `json:"expiration_height"`
+	Currency         string      `json:"currency"`
 }
 
 func (fact *AddTemplateFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -83,6 +116,17 @@ func (fact *AddTemplateFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		uf.SubjectKey,
 		uf.Description,
 		uf.Creator,
+		uf.Schema,
+		uf.SchemaHash,
+		uf.Auditors,
+		uf.AuditThreshold,
+		uf.OfferLifetime,
+		uf.Prerequisites,
+		uf.Cascade,
+		uf.MaxPerHolder,
+		uf.UniqueValue,
+		uf.ServiceHeight,
+		uf.ExpirationHeight,
 		uf.Currency,
 	); err != nil {
 		return common.DecorateError(err, common.ErrDecodeJson, *fact)
@@ -10,7 +10,6 @@ import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
-	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -77,6 +76,16 @@ func (opp *AddTemplateProcessor) PreProcess(
 				Errorf("%v", err)), nil
 	}
 
+	// NOTE the multi audit templates without auditors were allowed by the
+	// previous versions, so the facts in the old blocks are still valid; new
+	// ones are rejected.
+	if fact.MultiAudit() && len(fact.Auditors()) < 1 {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMValueInvalid).
+				Errorf("empty auditors for multi audit template %v", fact.TemplateID())), nil
+	}
+
 	if err := currencystate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
@@ -98,6 +107,18 @@ func (opp *AddTemplateProcessor) PreProcess(
 				Errorf("%v: creator %v is contract account", cErr, fact.Contract())), nil
 	}
 
+	for _, auditor := range fact.Auditors() {
+		if _, _, aErr, cErr := currencystate.ExistsCAccount(auditor, "auditor", true, false, getStateFunc); aErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Errorf("%v", aErr)), nil
+		} else if cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+					Errorf("%v: auditor %v is contract account", cErr, auditor)), nil
+		}
+	}
+
 	_, cSt, aErr, cErr := currencystate.ExistsCAccount(fact.Contract(), "contract", true, true, getStateFunc)
 	if aErr != nil {
 		return ctx, base.NewBaseOperationProcessReasonError(
@@ -109,8 +130,8 @@ func (opp *AddTemplateProcessor) PreProcess(
 				Errorf("%v", cErr)), nil
 	}
 
-	_, err := extensioncurrency.CheckCAAuthFromState(cSt, fact.Sender())
-	if err != nil {
+	if err := checkTemplateRole(
+		cSt, fact.Sender(), fact.Contract(), fact.TemplateID(), types.RoleTemplateAdmin, getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.
 				Errorf("%v", err)), nil
@@ -132,6 +153,7 @@ func (opp *AddTemplateProcessor) PreProcess(
 				Errorf("credential design state value for contract account %v", fact.Contract())), nil
 	}
 
+	registered := map[string]struct{}{}
 	for _, templateID := range design.Policy().TemplateIDs() {
 		if templateID == fact.TemplateID() {
 			return ctx, base.NewBaseOperationProcessReasonError(
@@ -139,6 +161,23 @@ func (opp *AddTemplateProcessor) PreProcess(
 					Wrap(common.ErrMStateE).
 					Errorf("already registered template %v in contract account %v", fact.TemplateID(), fact.Contract())), nil
 		}
+
+		registered[templateID] = struct{}{}
+	}
+
+	for _, templateID := range fact.Prerequisites() {
+		if _, found := registered[templateID]; !found {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Wrap(common.ErrMValueInvalid).
+					Errorf("prerequisite template %v not registered in contract account %v", templateID, fact.Contract())), nil
+		}
+	}
+
+	if err := checkServiceOpen(fact.Contract(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
 	}
 
 	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
@@ -163,12 +202,12 @@ func (opp *AddTemplateProcessor) Process(
 	sort.Slice(templateIDs, func(i int, j int) bool {
 		return templateIDs[i] < templateIDs[j]
 	})
-	policy := types.NewPolicy(templateIDs, design.Policy().Holders(), design.Policy().CredentialCount())
+	policy := design.Policy().SetTemplateIDs(templateIDs)
 	if err := policy.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid credential policy, %s; %w", fact.Contract(), err), nil
 	}
 
-	design = types.NewDesign(policy)
+	design = design.SetPolicy(policy)
 	if err := design.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid credential design, %s; %w", fact.Contract(), err), nil
 	}
@@ -176,8 +215,11 @@ func (opp *AddTemplateProcessor) Process(
 	template := types.NewTemplate(
 		fact.TemplateID(), fact.TemplateName(), fact.ServiceDate(), fact.ExpirationDate(),
 		fact.TemplateShare(), fact.MultiAudit(), fact.DisplayName(), fact.SubjectKey(),
-		fact.Description(), fact.Creator(),
-	)
+		fact.Description(), fact.Creator(), fact.Schema(), fact.SchemaHash(),
+		fact.Auditors(), fact.AuditThreshold(),
+	).SetOfferLifetime(fact.OfferLifetime()).SetPrerequisites(fact.Prerequisites(), fact.Cascade()).
+		SetIssuanceLimits(fact.MaxPerHolder(), fact.UniqueValue()).
+		SetServiceHeights(fact.ServiceHeight(), fact.ExpirationHeight())
 	if err := template.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid template, %q; %w", fact.TemplateID(), err), nil
 	}
@@ -0,0 +1,159 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	AmendCredentialFactHint = hint.MustNewHint("mitum-credential-amend-credential-operation-fact-v0.0.1")
+	AmendCredentialHint     = hint.MustNewHint("mitum-credential-amend-credential-operation-v0.0.1")
+)
+
+// AmendCredentialFact replaces the value of the active credential of the
+// template in contract with value.
+type AmendCredentialFact struct {
+	base.BaseFact
+	sender       base.Address
+	contract     base.Address
+	templateID   string
+	credentialID string
+	value        string
+	currency     crcytypes.CurrencyID
+}
+
+func NewAmendCredentialFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	credentialID string,
+	value string,
+	currency crcytypes.CurrencyID,
+) AmendCredentialFact {
+	bf := base.NewBaseFact(AmendCredentialFactHint, token)
+	fact := AmendCredentialFact{
+		BaseFact:     bf,
+		sender:       sender,
+		contract:     contract,
+		templateID:   templateID,
+		credentialID: credentialID,
+		value:        value,
+		currency:     currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact AmendCredentialFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact AmendCredentialFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AmendCredentialFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		[]byte(fact.credentialID),
+		[]byte(fact.value),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact AmendCredentialFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > types.MaxLengthTemplateID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d, but %d", types.MaxLengthTemplateID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.templateID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.TemplateID())))
+	}
+
+	if l := utf8.RuneCountInString(fact.credentialID); l < 1 || l > types.MaxLengthCredentialID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of credential ID <= %d, but %d", types.MaxLengthCredentialID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.credentialID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("credential ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.CredentialID())))
+	}
+
+	if l := utf8.RuneCountInString(fact.value); l < 1 || l > types.MaxLengthCredentialValue {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of credential value <= %d, but %d", types.MaxLengthCredentialValue, l)))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact AmendCredentialFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact AmendCredentialFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AmendCredentialFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact AmendCredentialFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact AmendCredentialFact) CredentialID() string {
+	return fact.credentialID
+}
+
+func (fact AmendCredentialFact) Value() string {
+	return fact.value
+}
+
+func (fact AmendCredentialFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact AmendCredentialFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type AmendCredential struct {
+	common.BaseOperation
+}
+
+func NewAmendCredential(fact AmendCredentialFact) AmendCredential {
+	return AmendCredential{BaseOperation: common.NewBaseOperation(AmendCredentialHint, fact)}
+}
@@ -0,0 +1,90 @@
+package credential // nolint: dupl
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact AmendCredentialFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":         fact.Hint().String(),
+			"sender":        fact.sender,
+			"contract":      fact.contract,
+			"template_id":   fact.templateID,
+			"credential_id": fact.credentialID,
+			"value":         fact.value,
+			"currency":      fact.currency,
+			"hash":          fact.BaseFact.Hash().String(),
+			"token":         fact.BaseFact.Token(),
+		},
+	)
+}
+
+type AmendCredentialFactBSONUnmarshaler struct {
+	Hint         string `bson:"_hint"`
+	Sender       string `bson:"sender"`
+	Contract     string `bson:"contract"`
+	TemplateID   string `bson:"template_id"`
+	CredentialID string `bson:"credential_id"`
+	Value        string `bson:"value"`
+	Currency     string `bson:"currency"`
+}
+
+func (fact *AmendCredentialFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubf common.BaseFactBSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf AmendCredentialFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.TemplateID,
+		uf.CredentialID,
+		uf.Value,
+		uf.Currency)
+}
+
+func (op AmendCredential) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *AmendCredential) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of AmendCredential")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
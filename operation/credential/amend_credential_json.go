@@ -0,0 +1,84 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type AmendCredentialFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner        base.Address             `json:"sender"`
+	Contract     base.Address             `json:"contract"`
+	TemplateID   string                   `json:"template_id"`
+	CredentialID string                   `json:"credential_id"`
+	Value        string                   `json:"value"`
+	Currency     currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact AmendCredentialFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AmendCredentialFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		CredentialID:          fact.credentialID,
+		Value:                 fact.value,
+		Currency:              fact.currency,
+	})
+}
+
+type AmendCredentialFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner        string `json:"sender"`
+	Contract     string `json:"contract"`
+	TemplateID   string `json:"template_id"`
+	CredentialID string `json:"credential_id"`
+	Value        string `json:"value"`
+	Currency     string `json:"currency"`
+}
+
+func (fact *AmendCredentialFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf AmendCredentialFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.TemplateID,
+		uf.CredentialID,
+		uf.Value,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type AmendCredentialMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op AmendCredential) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AmendCredentialMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *AmendCredential) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
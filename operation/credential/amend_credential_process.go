@@ -0,0 +1,183 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var amendCredentialProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AmendCredentialProcessor)
+	},
+}
+
+func (AmendCredential) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type AmendCredentialProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewAmendCredentialProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new AmendCredentialProcessor")
+
+		nopp := amendCredentialProcessorPool.Get()
+		opp, ok := nopp.(*AmendCredentialProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected AmendCredentialProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *AmendCredentialProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(AmendCredentialFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", AmendCredentialFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if _, _, aErr, cErr := currencystate.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: sender %v is contract account", cErr, fact.Sender())), nil
+	}
+
+	if _, _, aErr, cErr := currencystate.ExistsCAccount(fact.Contract(), "contract", true, true, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", cErr)), nil
+	}
+
+	if _, err := checkAmendable(
+		fact.Sender(), fact.Contract(), fact.TemplateID(), fact.CredentialID(), fact.Value(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkServiceOpen(fact.Contract(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AmendCredentialProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process AmendCredential")
+
+	fact, ok := op.Fact().(AmendCredentialFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected AmendCredentialFact, not %T", op.Fact())
+	}
+
+	cv, err := checkAmendable(
+		fact.Sender(), fact.Contract(), fact.TemplateID(), fact.CredentialID(), fact.Value(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	sts := []base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			state.StateKeyCredential(fact.Contract(), fact.TemplateID(), fact.CredentialID()),
+			cv.SetCredential(cv.Credential.SetValue(fact.Value())).SetVersion(cv.Version+1),
+		),
+	}
+
+	template, err := credentialTemplate(fact.Contract(), cv.Credential, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if template.UniqueValue() {
+		k := state.StateKeyValueCredentials(fact.Contract(), fact.TemplateID(), fact.Value())
+
+		refs, err := credentialIndex(k, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+
+		ref := types.NewCredentialRef(fact.TemplateID(), fact.CredentialID())
+		if indexOfCredentialRef(refs, ref) < 0 {
+			sts = append(sts, currencystate.NewStateMergeValue(
+				k, state.NewCredentialIndexStateValue(append(append([]types.CredentialRef{}, refs...), ref)),
+			))
+		}
+	}
+
+	feeSts, rErr, err := processCredentialItemsFee(getStateFunc, fact.Sender(), []CredentialItem{fact})
+	if rErr != nil || err != nil {
+		return nil, rErr, err
+	}
+
+	return append(sts, feeSts...), nil, nil
+}
+
+func (opp *AmendCredentialProcessor) Close() error {
+	amendCredentialProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ApproveCredentialRequestFactHint = hint.MustNewHint("mitum-credential-approve-credential-request-operation-fact-v0.0.1")
+	ApproveCredentialRequestHint     = hint.MustNewHint("mitum-credential-approve-credential-request-operation-v0.0.1")
+)
+
+// ApproveCredentialRequestFact approves the pending credential request of
+// requestID by issuing the credential of item. The holder and the value of item
+// should be the ones of the request.
+type ApproveCredentialRequestFact struct {
+	base.BaseFact
+	sender    base.Address
+	requestID string
+	item      IssueItem
+}
+
+func NewApproveCredentialRequestFact(
+	token []byte, sender base.Address, requestID string, item IssueItem,
+) ApproveCredentialRequestFact {
+	bf := base.NewBaseFact(ApproveCredentialRequestFactHint, token)
+	fact := ApproveCredentialRequestFact{
+		BaseFact:  bf,
+		sender:    sender,
+		requestID: requestID,
+		item:      item,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ApproveCredentialRequestFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ApproveCredentialRequestFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveCredentialRequestFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		[]byte(fact.requestID),
+		fact.item.Bytes(),
+	)
+}
+
+func (fact ApproveCredentialRequestFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.item,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(fact.requestID); l < 1 || l > types.MaxLengthRequestID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of request ID <= %d, but %d", types.MaxLengthRequestID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.requestID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("request ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.requestID)))
+	}
+
+	if fact.item.Contract().Equal(fact.sender) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if fact.item.Holder().Equal(fact.sender) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with holder", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ApproveCredentialRequestFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ApproveCredentialRequestFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveCredentialRequestFact) RequestID() string {
+	return fact.requestID
+}
+
+func (fact ApproveCredentialRequestFact) Item() IssueItem {
+	return fact.item
+}
+
+func (fact ApproveCredentialRequestFact) Addresses() ([]base.Address, error) {
+	return append(fact.item.Addresses(), fact.sender), nil
+}
+
+type ApproveCredentialRequest struct {
+	common.BaseOperation
+}
+
+func NewApproveCredentialRequest(fact ApproveCredentialRequestFact) ApproveCredentialRequest {
+	return ApproveCredentialRequest{BaseOperation: common.NewBaseOperation(ApproveCredentialRequestHint, fact)}
+}
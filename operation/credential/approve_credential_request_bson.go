@@ -0,0 +1,79 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact ApproveCredentialRequestFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      fact.Hint().String(),
+			"sender":     fact.sender,
+			"request_id": fact.requestID,
+			"item":       fact.item,
+			"hash":       fact.BaseFact.Hash().String(),
+			"token":      fact.BaseFact.Token(),
+		},
+	)
+}
+
+type ApproveCredentialRequestFactBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Sender    string   `bson:"sender"`
+	RequestID string   `bson:"request_id"`
+	Item      bson.Raw `bson:"item"`
+}
+
+func (fact *ApproveCredentialRequestFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubf common.BaseFactBSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf ApproveCredentialRequestFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	if err := fact.unpack(enc, uf.Sender, uf.RequestID, uf.Item); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	return nil
+}
+
+func (op ApproveCredentialRequest) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *ApproveCredentialRequest) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package credential
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type ApproveCredentialRequestFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender    base.Address `json:"sender"`
+	RequestID string       `json:"request_id"`
+	Item      IssueItem    `json:"item"`
+}
+
+func (fact ApproveCredentialRequestFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveCredentialRequestFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		RequestID:             fact.requestID,
+		Item:                  fact.item,
+	})
+}
+
+type ApproveCredentialRequestFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender    string          `json:"sender"`
+	RequestID string          `json:"request_id"`
+	Item      json.RawMessage `json:"item"`
+}
+
+func (fact *ApproveCredentialRequestFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf ApproveCredentialRequestFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Sender, uf.RequestID, uf.Item); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type ApproveCredentialRequestMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op ApproveCredentialRequest) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveCredentialRequestMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ApproveCredentialRequest) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var approveCredentialRequestProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ApproveCredentialRequestProcessor)
+	},
+}
+
+func (ApproveCredentialRequest) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type ApproveCredentialRequestProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewApproveCredentialRequestProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new ApproveCredentialRequestProcessor")
+
+		nopp := approveCredentialRequestProcessorPool.Get()
+		opp, ok := nopp.(*ApproveCredentialRequestProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ApproveCredentialRequestProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ApproveCredentialRequestProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(ApproveCredentialRequestFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ApproveCredentialRequestFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if _, _, aErr, cErr := currencystate.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: sender %v is contract account", cErr, fact.Sender())), nil
+	}
+
+	it := fact.Item()
+
+	request, err := checkPendingRequest(it.Contract(), it.TemplateID(), fact.RequestID(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	switch {
+	case !request.Holder.Equal(it.Holder()):
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMValueInvalid).
+				Errorf("credential request %v is requested by %v, not %v",
+					fact.RequestID(), request.Holder, it.Holder())), nil
+	case request.Value != it.Value():
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMValueInvalid).
+				Errorf("credential value is different from the value of credential request %v", fact.RequestID())), nil
+	case it.TemplateContract() != nil:
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMValueInvalid).
+				Errorf("credential request %v is for template of contract account %v",
+					fact.RequestID(), it.Contract())), nil
+	}
+
+	// NOTE the holder requested the credential, so the credential of template
+	// requiring consent of holder is issued without offer.
+	template, err := checkIssuable(fact.Sender(), it, opp.Height(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkIssuanceLimits(
+		it.Contract(), it.Holder(), template, it.Value(), "", nil, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkServiceOpen(it.Contract(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ApproveCredentialRequestProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process ApproveCredentialRequest")
+
+	fact, ok := op.Fact().(ApproveCredentialRequestFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected ApproveCredentialRequestFact, not %T", op.Fact())
+	}
+
+	it := fact.Item()
+
+	request, err := checkPendingRequest(it.Contract(), it.TemplateID(), fact.RequestID(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	stats, err := newHolderStats(it.Contract(), opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"credential design value not found, %s; %w", it.Contract(), err), nil
+	}
+
+	quotas := newIssuanceQuotas(opp.Height())
+	if err := quotas.add(it.Contract(), it.TemplateOwner(), it.TemplateID(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	ip := issueItemProcessorPool.Get()
+	ipc, ok := ip.(*IssueItemProcessor)
+	if !ok {
+		return nil, nil, e.Errorf("expected IssueItemProcessor, not %T", ip)
+	}
+
+	ipc.h = op.Hash()
+	ipc.sender = fact.Sender()
+	ipc.item = it
+	ipc.stats = stats
+
+	sts, err := ipc.Process(ctx, op, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to process IssueItem; %w", err), nil
+	}
+
+	ipc.Close()
+
+	sts = append(sts, currencystate.NewStateMergeValue(
+		state.StateKeyRequest(it.Contract(), fact.RequestID()),
+		request.Approve(it.CredentialID()),
+	))
+
+	statSts, err := stats.states()
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+	sts = append(sts, statSts...)
+	sts = append(sts, quotas.states()...)
+
+	feeSts, rErr, err := processCredentialItemsFee(getStateFunc, fact.Sender(), []CredentialItem{it})
+	if rErr != nil || err != nil {
+		return nil, rErr, err
+	}
+
+	return append(sts, feeSts...), nil, nil
+}
+
+func (opp *ApproveCredentialRequestProcessor) Close() error {
+	approveCredentialRequestProcessorPool.Put(opp)
+
+	return nil
+}
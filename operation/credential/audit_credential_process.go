@@ -0,0 +1,287 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	cstate "github.com/ProtoconNet/mitum-currency/v3/state"
+	statec "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var auditCredentialItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AuditCredentialItemProcessor)
+	},
+}
+
+var auditCredentialProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AuditCredentialProcessor)
+	},
+}
+
+func (AuditCredential) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type AuditCredentialItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   AuditCredentialItem
+}
+
+func (ipp *AuditCredentialItemProcessor) PreProcess(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	e := util.StringError("preprocess AuditCredentialItemProcessor")
+	it := ipp.item
+
+	if err := it.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := cstate.CheckExistsState(statec.DesignStateKey(it.Currency()), getStateFunc); err != nil {
+		return e.Wrap(common.ErrCurrencyNF.Wrap(errors.Errorf("currency id %v", it.Currency())))
+	}
+
+	if _, _, aErr, cErr := cstate.ExistsCAccount(it.Contract(), "contract", true, true, getStateFunc); aErr != nil {
+		return e.Wrap(aErr)
+	} else if cErr != nil {
+		return e.Wrap(cErr)
+	}
+
+	if err := checkServiceOpen(it.Contract(), getStateFunc); err != nil {
+		return e.Wrap(err)
+	}
+
+	cv, err := existsCredential(it.Contract(), it.TemplateID(), it.CredentialID(), getStateFunc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	template, err := credentialTemplate(it.Contract(), cv.Credential, getStateFunc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if !template.AuditRequired() {
+		return e.Wrap(common.ErrValueInvalid.Errorf(
+			"template %v in contract account %v has no auditors", it.TemplateID(), it.Contract()))
+	}
+
+	if !template.IsAuditor(ipp.sender) {
+		return e.Wrap(common.ErrAccountNAth.Errorf(
+			"sender %v is not auditor of template %v in contract account %v", ipp.sender, it.TemplateID(), it.Contract()))
+	}
+
+	if !cv.Credential.Holder().Equal(it.Holder()) {
+		return e.Wrap(common.ErrValueInvalid.Errorf(
+			"holder %v has not owned credential %v for template %v in contract account %v",
+			it.Holder(), it.CredentialID(), it.TemplateID(), it.Contract()))
+	}
+
+	if cv.Status != types.CredentialStatusPending {
+		return e.Wrap(common.ErrValueInvalid.Errorf(
+			"only pending credential can be audited, credential %v for template %v in contract account %v is %v",
+			it.CredentialID(), it.TemplateID(), it.Contract(), cv.Status))
+	}
+
+	if cv.IsApprovedBy(ipp.sender) {
+		return e.Wrap(common.ErrValueInvalid.Errorf(
+			"auditor %v already approved credential %v for template %v in contract account %v",
+			ipp.sender, it.CredentialID(), it.TemplateID(), it.Contract()))
+	}
+
+	return nil
+}
+
+func (ipp *AuditCredentialItemProcessor) Process(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	it := ipp.item
+
+	cv, err := existsCredential(it.Contract(), it.TemplateID(), it.CredentialID(), getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := credentialTemplate(it.Contract(), cv.Credential, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := make([]base.Address, len(cv.Approvals)+1)
+	copy(approvals, cv.Approvals)
+	approvals[len(cv.Approvals)] = ipp.sender
+
+	status := types.CredentialStatusPending
+	if uint64(len(approvals)) >= template.AuditThreshold() {
+		status = types.CredentialStatusActive
+	}
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(
+			state.StateKeyCredential(it.Contract(), it.TemplateID(), it.CredentialID()),
+			cv.SetStatus(status).SetApprovals(approvals),
+		),
+	}, nil
+}
+
+func (ipp *AuditCredentialItemProcessor) Close() {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = AuditCredentialItem{}
+
+	auditCredentialItemProcessorPool.Put(ipp)
+}
+
+type AuditCredentialProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewAuditCredentialProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new AuditCredentialProcessor")
+
+		nopp := auditCredentialProcessorPool.Get()
+		opp, ok := nopp.(*AuditCredentialProcessor)
+		if !ok {
+			return nil, e.Errorf("expected AuditCredentialProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *AuditCredentialProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(AuditCredentialFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", AuditCredentialFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if _, _, aErr, cErr := cstate.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: sender is contract account, %q", fact.Sender(), cErr)), nil
+	}
+
+	if err := cstate.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	for _, it := range fact.Items() {
+		ip := auditCredentialItemProcessorPool.Get()
+		ipc, ok := ip.(*AuditCredentialItemProcessor)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMTypeMismatch.Errorf("expected AuditCredentialItemProcessor, not %T", ip)), nil
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Errorf("%v", err),
+			), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AuditCredentialProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process AuditCredential")
+
+	fact, ok := op.Fact().(AuditCredentialFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected AuditCredentialFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for _, it := range fact.Items() {
+		ip := auditCredentialItemProcessorPool.Get()
+		ipc, ok := ip.(*AuditCredentialItemProcessor)
+		if !ok {
+			return nil, nil, e.Errorf("expected AuditCredentialItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+
+		st, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process AuditCredentialItem; %w", err), nil
+		}
+
+		sts = append(sts, st...)
+		ipc.Close()
+	}
+
+	items := make([]CredentialItem, len(fact.Items()))
+	for i := range fact.Items() {
+		items[i] = fact.Items()[i]
+	}
+
+	feeSts, rErr, err := processCredentialItemsFee(getStateFunc, fact.Sender(), items)
+	if rErr != nil || err != nil {
+		return nil, rErr, err
+	}
+	sts = append(sts, feeSts...)
+
+	return sts, nil, nil
+}
+
+func (opp *AuditCredentialProcessor) Close() error {
+	auditCredentialProcessorPool.Put(opp)
+
+	return nil
+}
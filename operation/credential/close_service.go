@@ -0,0 +1,125 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	CloseServiceFactHint = hint.MustNewHint("mitum-credential-close-service-operation-fact-v0.0.1")
+	CloseServiceHint     = hint.MustNewHint("mitum-credential-close-service-operation-v0.0.1")
+)
+
+type CloseServiceFact struct {
+	base.BaseFact
+	sender            base.Address
+	contract          base.Address
+	revokeCredentials bool
+	currency          crcytypes.CurrencyID
+}
+
+func NewCloseServiceFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	revokeCredentials bool,
+	currency crcytypes.CurrencyID,
+) CloseServiceFact {
+	bf := base.NewBaseFact(CloseServiceFactHint, token)
+	fact := CloseServiceFact{
+		BaseFact:          bf,
+		sender:            sender,
+		contract:          contract,
+		revokeCredentials: revokeCredentials,
+		currency:          currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CloseServiceFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CloseServiceFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CloseServiceFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		types.Bool(fact.revokeCredentials).Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact CloseServiceFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact CloseServiceFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CloseServiceFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CloseServiceFact) Contract() base.Address {
+	return fact.contract
+}
+
+// RevokeCredentials reports whether the credentials of the templates of the
+// credential service are revoked as the service is closed. An operation
+// examines at most MaxRevokeAllCredentials credentials from the revocation
+// cursor of the service; the closed service is closed again with
+// RevokeCredentials to continue the revocation until the credential pages of
+// the templates are all walked.
+func (fact CloseServiceFact) RevokeCredentials() bool {
+	return fact.revokeCredentials
+}
+
+func (fact CloseServiceFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact CloseServiceFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type CloseService struct {
+	common.BaseOperation
+}
+
+func NewCloseService(fact CloseServiceFact) CloseService {
+	return CloseService{BaseOperation: common.NewBaseOperation(CloseServiceHint, fact)}
+}
@@ -0,0 +1,81 @@
+package credential // nolint: dupl
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact CloseServiceFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":              fact.Hint().String(),
+			"sender":             fact.sender,
+			"contract":           fact.contract,
+			"revoke_credentials": fact.revokeCredentials,
+			"currency":           fact.currency,
+			"hash":               fact.BaseFact.Hash().String(),
+			"token":              fact.BaseFact.Token(),
+		},
+	)
+}
+
+type CloseServiceFactBSONUnmarshaler struct {
+	Hint              string `bson:"_hint"`
+	Sender            string `bson:"sender"`
+	Contract          string `bson:"contract"`
+	RevokeCredentials bool   `bson:"revoke_credentials"`
+	Currency          string `bson:"currency"`
+}
+
+func (fact *CloseServiceFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubf common.BaseFactBSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf CloseServiceFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	if err := fact.unpack(enc, uf.Sender, uf.Contract, uf.RevokeCredentials, uf.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	return nil
+}
+
+func (op CloseService) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *CloseService) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
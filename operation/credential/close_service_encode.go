@@ -0,0 +1,28 @@
+package credential
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *CloseServiceFact) unpack(enc encoder.Encoder, sAdr, cAdr string, revokeCredentials bool, cid string) error {
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	fact.revokeCredentials = revokeCredentials
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	return nil
+}
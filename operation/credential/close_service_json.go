@@ -0,0 +1,71 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type CloseServiceFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner             base.Address             `json:"sender"`
+	Contract          base.Address             `json:"contract"`
+	RevokeCredentials bool                     `json:"revoke_credentials"`
+	Currency          currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact CloseServiceFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CloseServiceFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		RevokeCredentials:     fact.revokeCredentials,
+		Currency:              fact.currency,
+	})
+}
+
+type CloseServiceFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner             string `json:"sender"`
+	Contract          string `json:"contract"`
+	RevokeCredentials bool   `json:"revoke_credentials"`
+	Currency          string `json:"currency"`
+}
+
+func (fact *CloseServiceFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf CloseServiceFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Owner, uf.Contract, uf.RevokeCredentials, uf.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type CloseServiceMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op CloseService) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CloseServiceMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *CloseService) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
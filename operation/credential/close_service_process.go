@@ -0,0 +1,230 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var closeServiceProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CloseServiceProcessor)
+	},
+}
+
+func (CloseService) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type CloseServiceProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCloseServiceProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new CloseServiceProcessor")
+
+		nopp := closeServiceProcessorPool.Get()
+		opp, ok := nopp.(*CloseServiceProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected CloseServiceProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CloseServiceProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(CloseServiceFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", CloseServiceFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if _, _, aErr, cErr := currencystate.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: sender %v is contract account", cErr, fact.Sender())), nil
+	}
+
+	_, cSt, aErr, cErr := currencystate.ExistsCAccount(fact.Contract(), "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", cErr)), nil
+	}
+
+	ca, err := extensioncurrency.LoadCAStateValue(cSt)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if !ca.Owner().Equal(fact.Sender()) {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v is not owner of contract account %v", fact.Sender(), fact.Contract())), nil
+	}
+
+	design, err := serviceDesign(fact.Contract(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if design.Closed() {
+		cursor, err := revocationCursor(state.StateKeyServiceRevocationCursor(fact.Contract()), getStateFunc)
+		if err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Errorf("%v", err)), nil
+		}
+
+		// NOTE the closed credential service is closed again only to
+		// continue the revocation of its credentials.
+		if !fact.RevokeCredentials() || !cursor.InProgress() {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Wrap(common.ErrMValueInvalid).
+					Errorf("credential service of contract account %v already closed at height %v",
+						fact.Contract(), design.ClosedAt())), nil
+		}
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CloseServiceProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process CloseService")
+
+	fact, ok := op.Fact().(CloseServiceFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected CloseServiceFact, not %T", op.Fact())
+	}
+
+	stats, err := newHolderStats(fact.Contract(), opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"credential design value not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	if err := stats.migrate(getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	var sts []base.StateMergeValue
+
+	if fact.RevokeCredentials() {
+		k := state.StateKeyServiceRevocationCursor(fact.Contract())
+
+		cursor, err := revocationCursor(k, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+
+		refs, cursor, err := nextServiceCredentials(
+			fact.Contract(), stats.design, cursor, int(MaxRevokeAllCredentials), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+
+		rSts, err := revokeCredentials(
+			fact.Contract(), refs,
+			types.NewRevocation(
+				types.RevocationReasonCessationOfOperation, "credential service closed", fact.Sender(), opp.Height()),
+			stats, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+		sts = append(sts, rSts...)
+		sts = append(sts, currencystate.NewStateMergeValue(k, cursor))
+	}
+
+	// NOTE the contract account is kept active; the other models check the
+	// activation of the contract account to register, so the deactivated
+	// account could be taken over by them. The closure in the design
+	// freezes the credential service instead.
+	if !stats.design.Closed() {
+		stats.setDesign(stats.design.SetClosed(opp.Height()))
+	}
+
+	statSts, err := stats.states()
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+	sts = append(sts, statSts...)
+
+	feeSts, rErr, err := processCredentialItemsFee(getStateFunc, fact.Sender(), []CredentialItem{fact})
+	if rErr != nil || err != nil {
+		return nil, rErr, err
+	}
+
+	return append(sts, feeSts...), nil, nil
+}
+
+func (opp *CloseServiceProcessor) Close() error {
+	closeServiceProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package credential
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+// TestClosedServiceRejectsOperations checks that the operations changing the
+// credentials, the requests or the roles of the closed credential service are
+// rejected.
+func TestClosedServiceRejectsOperations(t *testing.T) {
+	r := newRevokeAllTest(t, 2)
+	ca := r.contract[0].Address()
+	sender, priv := r.sender[0].Address(), r.sender[0].Priv()
+
+	p := NewTestCloseServiceProcessor(r.TestProcessor)
+	p.SetRevokeCredentials(false)
+	p.Opr, _ = NewCloseServiceProcessor()(base.Height(10), p.GetStateFunc, nil, nil)
+	p.MakeOperation(sender, priv, ca, r.GenesisCurrency).RunPreProcess()
+	if err := p.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	p.RunProcess()
+	if err := p.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]func() error{
+		"revoke": func() error {
+			items := make([]RevokeItem, 1)
+
+			rp := NewTestRevokeProcessor(r.TestProcessor)
+			rp.Opr, _ = NewRevokeProcessor()(base.Height(11), rp.GetStateFunc, nil, nil)
+			rp.SetTemplate("t1", "credential0").SetRevocation(types.RevocationReasonUnspecified, "").
+				MakeItem(r.contract[0], r.holders[0], r.GenesisCurrency, items).
+				MakeOperation(sender, priv, items).RunPreProcess()
+
+			return rp.Error()
+		},
+		"suspend": func() error {
+			items := make([]SuspendItem, 1)
+
+			sp := NewTestSuspendProcessor(r.TestProcessor)
+			sp.Opr, _ = NewSuspendProcessor()(base.Height(11), sp.GetStateFunc, nil, nil)
+			sp.SetTemplate("t1", "credential0").
+				MakeItem(r.contract[0], r.holders[0], r.GenesisCurrency, items).
+				MakeOperation(sender, priv, items).RunPreProcess()
+
+			return sp.Error()
+		},
+		"revoke all by template": func() error {
+			tp := NewTestRevokeAllByTemplateProcessor(r.TestProcessor)
+			tp.Opr, _ = NewRevokeAllByTemplateProcessor()(base.Height(11), tp.GetStateFunc, nil, nil)
+			tp.SetTemplate("t1").SetRevocation(types.RevocationReasonUnspecified, "")
+			tp.MakeOperation(sender, priv, ca, r.GenesisCurrency).RunPreProcess()
+
+			return tp.Error()
+		},
+		"revoke all by holder": func() error {
+			hp := NewTestRevokeAllByHolderProcessor(r.TestProcessor)
+			hp.SetRevocation(types.RevocationReasonUnspecified, "").Create().
+				MakeOperation(sender, priv, ca, r.holders[0].Address(), r.GenesisCurrency).
+				RunPreProcess()
+
+			return hp.Error()
+		},
+		"request credential": func() error {
+			qp := NewTestRequestCredentialProcessor(r.TestProcessor)
+			qp.Opr, _ = NewRequestCredentialProcessor()(base.Height(11), qp.GetStateFunc, nil, nil)
+			qp.SetTemplate("t1", "request0", "value").
+				MakeOperation(r.holders[1].Address(), r.holders[1].Priv(), ca, r.GenesisCurrency).
+				RunPreProcess()
+
+			return qp.Error()
+		},
+	}
+
+	for name, f := range cases {
+		switch err := f(); {
+		case err == nil:
+			t.Fatalf("%s in closed credential service", name)
+		case !strings.Contains(err.Error(), "closed at height"):
+			t.Fatalf("%s rejected not by the closure; %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	DeclineCredentialFactHint = hint.MustNewHint("mitum-credential-decline-credential-operation-fact-v0.0.1")
+	DeclineCredentialHint     = hint.MustNewHint("mitum-credential-decline-credential-operation-v0.0.1")
+)
+
+type DeclineCredentialFact struct {
+	base.BaseFact
+	sender       base.Address
+	contract     base.Address
+	templateID   string
+	credentialID string
+	currency     crcytypes.CurrencyID
+}
+
+func NewDeclineCredentialFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	credentialID string,
+	currency crcytypes.CurrencyID,
+) DeclineCredentialFact {
+	bf := base.NewBaseFact(DeclineCredentialFactHint, token)
+	fact := DeclineCredentialFact{
+		BaseFact:     bf,
+		sender:       sender,
+		contract:     contract,
+		templateID:   templateID,
+		credentialID: credentialID,
+		currency:     currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact DeclineCredentialFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact DeclineCredentialFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact DeclineCredentialFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		[]byte(fact.credentialID),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact DeclineCredentialFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > types.MaxLengthTemplateID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d, but %d", types.MaxLengthTemplateID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.templateID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.TemplateID())))
+	}
+
+	if l := utf8.RuneCountInString(fact.credentialID); l < 1 || l > types.MaxLengthCredentialID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of credential ID <= %d, but %d", types.MaxLengthCredentialID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.credentialID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("credential ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.CredentialID())))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact DeclineCredentialFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact DeclineCredentialFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact DeclineCredentialFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact DeclineCredentialFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact DeclineCredentialFact) CredentialID() string {
+	return fact.credentialID
+}
+
+func (fact DeclineCredentialFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact DeclineCredentialFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type DeclineCredential struct {
+	common.BaseOperation
+}
+
+func NewDeclineCredential(fact DeclineCredentialFact) DeclineCredential {
+	return DeclineCredential{BaseOperation: common.NewBaseOperation(DeclineCredentialHint, fact)}
+}
@@ -0,0 +1,129 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	DeprecateTemplateFactHint = hint.MustNewHint("mitum-credential-deprecate-template-operation-fact-v0.0.1")
+	DeprecateTemplateHint     = hint.MustNewHint("mitum-credential-deprecate-template-operation-v0.0.1")
+)
+
+type DeprecateTemplateFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	currency   crcytypes.CurrencyID
+}
+
+func NewDeprecateTemplateFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	currency crcytypes.CurrencyID,
+) DeprecateTemplateFact {
+	bf := base.NewBaseFact(DeprecateTemplateFactHint, token)
+	fact := DeprecateTemplateFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact DeprecateTemplateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact DeprecateTemplateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact DeprecateTemplateFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact DeprecateTemplateFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > types.MaxLengthTemplateID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d, but %d", types.MaxLengthTemplateID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.templateID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.TemplateID())))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact DeprecateTemplateFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact DeprecateTemplateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact DeprecateTemplateFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact DeprecateTemplateFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact DeprecateTemplateFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact DeprecateTemplateFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type DeprecateTemplate struct {
+	common.BaseOperation
+}
+
+func NewDeprecateTemplate(fact DeprecateTemplateFact) DeprecateTemplate {
+	return DeprecateTemplate{BaseOperation: common.NewBaseOperation(DeprecateTemplateHint, fact)}
+}
@@ -0,0 +1,76 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type DeprecateTemplateFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact DeprecateTemplateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DeprecateTemplateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		Currency:              fact.currency,
+	})
+}
+
+type DeprecateTemplateFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string `json:"sender"`
+	Contract   string `json:"contract"`
+	TemplateID string `json:"template_id"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *DeprecateTemplateFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf DeprecateTemplateFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.TemplateID,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type DeprecateTemplateMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op DeprecateTemplate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DeprecateTemplateMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *DeprecateTemplate) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
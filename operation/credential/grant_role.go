@@ -0,0 +1,177 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	GrantRoleFactHint = hint.MustNewHint("mitum-credential-grant-role-operation-fact-v0.0.1")
+	GrantRoleHint     = hint.MustNewHint("mitum-credential-grant-role-operation-v0.0.1")
+)
+
+type GrantRoleFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	account    base.Address
+	roles      []types.Role
+	// restrict enables the role-based access of the template; the handlers of
+	// contract account lose their authority on the template.
+	restrict types.Bool
+	currency crcytypes.CurrencyID
+}
+
+func NewGrantRoleFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	account base.Address,
+	roles []types.Role,
+	restrict types.Bool,
+	currency crcytypes.CurrencyID,
+) GrantRoleFact {
+	bf := base.NewBaseFact(GrantRoleFactHint, token)
+	fact := GrantRoleFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		account:    account,
+		roles:      roles,
+		restrict:   restrict,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact GrantRoleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact GrantRoleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact GrantRoleFact) Bytes() []byte {
+	rs := make([][]byte, len(fact.roles))
+	for i := range fact.roles {
+		rs[i] = fact.roles[i].Bytes()
+	}
+
+	var rb []byte
+	if fact.restrict {
+		rb = fact.restrict.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		fact.account.Bytes(),
+		util.ConcatBytesSlice(rs...),
+		rb,
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact GrantRoleFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.account,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > types.MaxLengthTemplateID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d, but %d", types.MaxLengthTemplateID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.templateID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.TemplateID())))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if fact.account.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("account %v is same with contract account", fact.account)))
+	}
+
+	if err := types.IsValidRoles(fact.roles); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact GrantRoleFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact GrantRoleFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact GrantRoleFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact GrantRoleFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact GrantRoleFact) Account() base.Address {
+	return fact.account
+}
+
+func (fact GrantRoleFact) Roles() []types.Role {
+	return fact.roles
+}
+
+// Restrict reports whether the fact enables the role-based access of the
+// template.
+func (fact GrantRoleFact) Restrict() types.Bool {
+	return fact.restrict
+}
+
+func (fact GrantRoleFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact GrantRoleFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 3)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	as[2] = fact.account
+	return as, nil
+}
+
+type GrantRole struct {
+	common.BaseOperation
+}
+
+func NewGrantRole(fact GrantRoleFact) GrantRole {
+	return GrantRole{BaseOperation: common.NewBaseOperation(GrantRoleHint, fact)}
+}
@@ -0,0 +1,96 @@
+package credential // nolint: dupl
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact GrantRoleFact) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":       fact.Hint().String(),
+		"sender":      fact.sender,
+		"contract":    fact.contract,
+		"template_id": fact.templateID,
+		"account":     fact.account,
+		"roles":       fact.roles,
+		"currency":    fact.currency,
+		"hash":        fact.BaseFact.Hash().String(),
+		"token":       fact.BaseFact.Token(),
+	}
+
+	if fact.restrict {
+		m["restrict"] = fact.restrict
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type GrantRoleFactBSONUnmarshaler struct {
+	Hint       string   `bson:"_hint"`
+	Sender     string   `bson:"sender"`
+	Contract   string   `bson:"contract"`
+	TemplateID string   `bson:"template_id"`
+	Account    string   `bson:"account"`
+	Roles      []string `bson:"roles"`
+	Restrict   bool     `bson:"restrict,omitempty"`
+	Currency   string   `bson:"currency"`
+}
+
+func (fact *GrantRoleFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubf common.BaseFactBSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf GrantRoleFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.TemplateID,
+		uf.Account,
+		uf.Roles,
+		uf.Restrict,
+		uf.Currency)
+}
+
+func (op GrantRole) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *GrantRole) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of GrantRole")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
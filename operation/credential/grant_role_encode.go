@@ -0,0 +1,47 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *GrantRoleFact) unpack(enc encoder.Encoder,
+	sAdr, cAdr, tmplID, aAdr string,
+	roles []string,
+	restrict bool,
+	cid string,
+) error {
+	fact.templateID = tmplID
+	fact.restrict = types.Bool(restrict)
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(aAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.account = a
+	}
+
+	fact.roles = make([]types.Role, len(roles))
+	for i := range roles {
+		fact.roles[i] = types.Role(roles[i])
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type GrantRoleFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	Account    base.Address             `json:"account"`
+	Roles      []types.Role             `json:"roles"`
+	Restrict   types.Bool               `json:"restrict,omitempty"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact GrantRoleFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GrantRoleFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		Account:               fact.account,
+		Roles:                 fact.roles,
+		Restrict:              fact.restrict,
+		Currency:              fact.currency,
+	})
+}
+
+type GrantRoleFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string   `json:"sender"`
+	Contract   string   `json:"contract"`
+	TemplateID string   `json:"template_id"`
+	Account    string   `json:"account"`
+	Roles      []string `json:"roles"`
+	Restrict   bool     `json:"restrict"`
+	Currency   string   `json:"currency"`
+}
+
+func (fact *GrantRoleFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf GrantRoleFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.TemplateID,
+		uf.Account,
+		uf.Roles,
+		uf.Restrict,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type GrantRoleMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op GrantRole) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GrantRoleMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *GrantRole) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
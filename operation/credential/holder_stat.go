@@ -0,0 +1,400 @@
+package credential
+
+import (
+	"sort"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	cstate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// holderStats keeps the aggregate counters of the credential service, the
+// credential counts of the holders, the credential indexes of the holders and
+// the credential pages of the templates at height while the items of an
+// operation are processed. The changes of the aggregate counters are merged
+// with the changes of the other operations at the height, see
+// state.DesignStateValueMerger. The holders kept in the design by the previous
+// versions are migrated to the holder-stat states when they are counted, or
+// all at once by migrate.
+type holderStats struct {
+	contract        base.Address
+	height          base.Height
+	design          types.Design
+	replaced        bool
+	holderCount     uint64
+	credentialCount uint64
+	unindexedCount  uint64
+	legacy          map[string]types.Holder
+	holders         map[string]types.Holder
+	indexes         map[string][]types.CredentialRef
+	pages           map[string]state.CredentialPageStateValue
+	removed         map[string]struct{}
+}
+
+func newHolderStats(
+	contract base.Address, height base.Height, getStateFunc base.GetStateFunc) (*holderStats, error) {
+	st, err := cstate.ExistsState(state.StateKeyDesign(contract), "design", getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := design.Policy()
+
+	hs := &holderStats{
+		contract:        contract,
+		height:          height,
+		design:          design,
+		holderCount:     policy.HolderCount(),
+		credentialCount: policy.CredentialCount(),
+		unindexedCount:  policy.UnindexedCount(),
+		legacy:          map[string]types.Holder{},
+		holders:         map[string]types.Holder{},
+		indexes:         map[string][]types.CredentialRef{},
+		pages:           map[string]state.CredentialPageStateValue{},
+		removed:         map[string]struct{}{},
+	}
+
+	for _, h := range policy.Holders() {
+		hs.legacy[h.Address().String()] = h
+	}
+
+	return hs, nil
+}
+
+// holder returns the credential count of holder. The holder-stat state is
+// preferred to the holder kept in the design by the previous versions.
+func (hs *holderStats) holder(holder base.Address, getStateFunc base.GetStateFunc) (types.Holder, error) {
+	if h, found := hs.holders[holder.String()]; found {
+		return h, nil
+	}
+
+	switch st, found, err := getStateFunc(state.StateKeyHolderStat(hs.contract, holder)); {
+	case err != nil:
+		return types.Holder{}, err
+	case found:
+		return state.StateHolderStatValue(st)
+	}
+
+	if h, found := hs.legacy[holder.String()]; found {
+		return h, nil
+	}
+
+	return types.NewHolder(holder, 0), nil
+}
+
+// migrate moves all the holders kept in the design by the previous versions to
+// the holder-stat states, and removes them from the design. The design is
+// written as a whole, so the operation migrating the holders should not be in
+// the same block with the other operations of the credential service.
+func (hs *holderStats) migrate(getStateFunc base.GetStateFunc) error {
+	if len(hs.legacy) < 1 {
+		return nil
+	}
+
+	for k, h := range hs.legacy {
+		if _, found := hs.holders[k]; found {
+			continue
+		}
+
+		switch _, found, err := getStateFunc(state.StateKeyHolderStat(hs.contract, h.Address())); {
+		case err != nil:
+			return err
+		case !found:
+			hs.holders[k] = h
+		}
+	}
+
+	policy, _ := hs.design.Policy().MigrateHolders()
+	hs.setDesign(hs.design.SetPolicy(policy))
+
+	return nil
+}
+
+// setDesign replaces the design of the credential service; the design is
+// written as a whole before the changes of the aggregate counters are added.
+func (hs *holderStats) setDesign(design types.Design) {
+	hs.design = design
+	hs.replaced = true
+}
+
+// index returns the credential references kept in the index state of key.
+func (hs *holderStats) index(key string, getStateFunc base.GetStateFunc) ([]types.CredentialRef, error) {
+	if refs, found := hs.indexes[key]; found {
+		return refs, nil
+	}
+
+	switch st, found, err := getStateFunc(key); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, nil
+	default:
+		return state.StateCredentialIndexValue(st)
+	}
+}
+
+// page appends ref to the credential page of the template of ref at the
+// height. The previous page is the last page of the template before the
+// height.
+func (hs *holderStats) page(ref types.CredentialRef, getStateFunc base.GetStateFunc) error {
+	page, found := hs.pages[ref.TemplateID()]
+	if !found {
+		previous := base.NilHeight
+
+		switch st, found, err := getStateFunc(state.StateKeyTemplateCredentials(hs.contract, ref.TemplateID())); {
+		case err != nil:
+			return err
+		case found:
+			last, err := state.StateCredentialPagesValue(st)
+			if err != nil {
+				return err
+			}
+
+			if last < hs.height {
+				previous = last
+			}
+		}
+
+		page = state.NewCredentialPageStateValue(previous, nil)
+	}
+
+	if indexOfCredentialRef(page.Credentials, ref) < 0 {
+		page.Credentials = append(page.Credentials, ref)
+	}
+
+	hs.pages[ref.TemplateID()] = page
+
+	return nil
+}
+
+// add counts the credential of ref issued to holder.
+func (hs *holderStats) add(holder base.Address, ref types.CredentialRef, getStateFunc base.GetStateFunc) error {
+	h, err := hs.holder(holder, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	k := state.StateKeyHolderCredentials(hs.contract, holder)
+
+	refs, err := hs.index(k, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if indexOfCredentialRef(refs, ref) < 0 {
+		hs.indexes[k] = append(append([]types.CredentialRef{}, refs...), ref)
+
+		if err := hs.page(ref, getStateFunc); err != nil {
+			return err
+		}
+	}
+
+	if h.CredentialCount() < 1 {
+		hs.holderCount++
+	}
+
+	hs.holders[holder.String()] = types.NewHolder(holder, h.CredentialCount()+1)
+	hs.credentialCount++
+
+	return nil
+}
+
+// remove uncounts the credential of ref of holder. The credential issued
+// before the credential index was introduced is not found in the holder index,
+// and is uncounted from the unindexed credentials. The credential pages of the
+// template keep ref.
+func (hs *holderStats) remove(holder base.Address, ref types.CredentialRef, getStateFunc base.GetStateFunc) error {
+	h, err := hs.holder(holder, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	k := state.StateKeyHolderCredentials(hs.contract, holder)
+
+	refs, err := hs.index(k, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	i := indexOfCredentialRef(refs, ref)
+	if i >= 0 {
+		nrefs := make([]types.CredentialRef, 0, len(refs)-1)
+		hs.indexes[k] = append(append(nrefs, refs[:i]...), refs[i+1:]...)
+	}
+
+	if i < 0 && hs.unindexedCount > 0 {
+		hs.unindexedCount--
+	}
+
+	switch {
+	case hs.credentialCount < 1:
+		return errors.Errorf("no credentials in credential service, %s", hs.contract)
+	case h.CredentialCount() < 1:
+		return errors.Errorf("no credentials of holder in credential service, %s, %s", hs.contract, holder)
+	case h.CredentialCount() == 1 && hs.holderCount > 0:
+		hs.holderCount--
+	}
+
+	hs.holders[holder.String()] = types.NewHolder(holder, h.CredentialCount()-1)
+	hs.credentialCount--
+	hs.removed[ref.String()] = struct{}{}
+
+	return nil
+}
+
+// backfill keeps the unindexed credential of ref of holder, which was issued
+// by the previous versions, in the holder index and the credential pages of
+// the template. The credential is counted already.
+func (hs *holderStats) backfill(holder base.Address, ref types.CredentialRef, getStateFunc base.GetStateFunc) error {
+	if hs.unindexedCount < 1 {
+		return errors.Errorf("no unindexed credentials in credential service, %s", hs.contract)
+	}
+
+	k := state.StateKeyHolderCredentials(hs.contract, holder)
+
+	refs, err := hs.index(k, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if indexOfCredentialRef(refs, ref) >= 0 {
+		return errors.Errorf("credential %s already indexed in credential service, %s", ref, hs.contract)
+	}
+
+	hs.indexes[k] = append(append([]types.CredentialRef{}, refs...), ref)
+
+	if err := hs.page(ref, getStateFunc); err != nil {
+		return err
+	}
+
+	hs.unindexedCount--
+
+	return nil
+}
+
+// addValue refers the credential of ref from the index of value of the
+// template of ref, which requires the unique credential value.
+func (hs *holderStats) addValue(ref types.CredentialRef, value string, getStateFunc base.GetStateFunc) error {
+	k := state.StateKeyValueCredentials(hs.contract, ref.TemplateID(), value)
+
+	refs, err := hs.index(k, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if indexOfCredentialRef(refs, ref) < 0 {
+		hs.indexes[k] = append(append([]types.CredentialRef{}, refs...), ref)
+	}
+
+	return nil
+}
+
+// isRemoved reports whether the credential of ref is uncounted already while
+// the operation is processed.
+func (hs *holderStats) isRemoved(ref types.CredentialRef) bool {
+	_, found := hs.removed[ref.String()]
+
+	return found
+}
+
+// states returns the design or the changes of the aggregate counters, the
+// holder-stat, the credential index and the credential page states. The
+// changes of the aggregate counters and the credential pages are merged with
+// the ones of the other operations at the height.
+func (hs *holderStats) states() ([]base.StateMergeValue, error) {
+	policy := hs.design.Policy()
+
+	counts := state.NewPolicyCountsStateValue(
+		int64(hs.holderCount)-int64(policy.HolderCount()),
+		int64(hs.credentialCount)-int64(policy.CredentialCount()),
+		int64(hs.unindexedCount)-int64(policy.UnindexedCount()),
+	)
+
+	design := hs.design.SetPolicy(policy.SetCounts(hs.holderCount, hs.credentialCount, hs.unindexedCount))
+	if err := design.IsValid(nil); err != nil {
+		return nil, errors.WithMessagef(err, "invalid design, %s", hs.contract)
+	}
+
+	keys := make([]string, 0, len(hs.holders))
+	for k := range hs.holders {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	sts := make([]base.StateMergeValue, 0, len(keys)+len(hs.indexes)+len(hs.pages)*2+2)
+
+	if hs.replaced {
+		sts = append(sts, state.NewDesignStateMergeValue(
+			state.StateKeyDesign(hs.contract), state.NewDesignStateValue(hs.design)))
+	}
+
+	if !counts.IsZero() {
+		sts = append(sts, state.NewDesignStateMergeValue(state.StateKeyDesign(hs.contract), counts))
+	}
+
+	for _, k := range keys {
+		h := hs.holders[k]
+		sts = append(sts, cstate.NewStateMergeValue(
+			state.StateKeyHolderStat(hs.contract, h.Address()),
+			state.NewHolderStatStateValue(h),
+		))
+	}
+
+	ikeys := make([]string, 0, len(hs.indexes))
+	for k := range hs.indexes {
+		ikeys = append(ikeys, k)
+	}
+	sort.Strings(ikeys)
+
+	for _, k := range ikeys {
+		sts = append(sts, cstate.NewStateMergeValue(k, state.NewCredentialIndexStateValue(hs.indexes[k])))
+	}
+
+	tids := make([]string, 0, len(hs.pages))
+	for k := range hs.pages {
+		tids = append(tids, k)
+	}
+	sort.Strings(tids)
+
+	for _, tid := range tids {
+		sts = append(sts,
+			cstate.NewStateMergeValue(
+				state.StateKeyTemplateCredentials(hs.contract, tid),
+				state.NewCredentialPagesStateValue(hs.height),
+			),
+			state.NewCredentialPageStateMergeValue(
+				state.StateKeyTemplateCredentialPage(hs.contract, tid, hs.height),
+				hs.pages[tid],
+			),
+		)
+	}
+
+	return sts, nil
+}
+
+func indexOfCredentialRef(refs []types.CredentialRef, ref types.CredentialRef) int {
+	for i := range refs {
+		if refs[i].TemplateID() == ref.TemplateID() && refs[i].CredentialID() == ref.CredentialID() {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func sortedHolderStatsKeys(stats map[string]*holderStats) []string {
+	keys := make([]string, 0, len(stats))
+	for k := range stats {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
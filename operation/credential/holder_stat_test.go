@@ -0,0 +1,19 @@
+package credential
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+)
+
+func TestHolderStatCounters(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		tp := &test.TestProcessor{}
+		tp.Setup(test.NewMockStateGetter())
+
+		p := NewTestHolderStatProcessor(tp, seed)
+		if err := p.SetService(5).Run(60); err != nil {
+			t.Fatalf("seed %d: %v", seed, err)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	IndexCredentialsFactHint = hint.MustNewHint("mitum-credential-index-credentials-operation-fact-v0.0.1")
+	IndexCredentialsHint     = hint.MustNewHint("mitum-credential-index-credentials-operation-v0.0.1")
+)
+
+var MaxIndexCredentials uint = 1000
+
+// IndexCredentialsFact keeps the credentials issued by the previous versions in
+// the credential indexes of the credential service, so RevokeAllByHolder,
+// RevokeAllByTemplate and CloseService can find them.
+type IndexCredentialsFact struct {
+	base.BaseFact
+	sender      base.Address
+	contract    base.Address
+	credentials []types.CredentialRef
+	currency    crcytypes.CurrencyID
+}
+
+func NewIndexCredentialsFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	credentials []types.CredentialRef,
+	currency crcytypes.CurrencyID,
+) IndexCredentialsFact {
+	bf := base.NewBaseFact(IndexCredentialsFactHint, token)
+	fact := IndexCredentialsFact{
+		BaseFact:    bf,
+		sender:      sender,
+		contract:    contract,
+		credentials: credentials,
+		currency:    currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact IndexCredentialsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact IndexCredentialsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact IndexCredentialsFact) Bytes() []byte {
+	rs := make([][]byte, len(fact.credentials))
+	for i := range fact.credentials {
+		rs[i] = fact.credentials[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.ConcatBytesSlice(rs...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact IndexCredentialsFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if n := len(fact.credentials); n < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("empty credentials")))
+	} else if n > int(MaxIndexCredentials) {
+		return common.ErrFactInvalid.Wrap(common.ErrArrayLen.Wrap(errors.Errorf("credentials, %d over max, %d", n, MaxIndexCredentials)))
+	}
+
+	founds := map[string]struct{}{}
+	for i := range fact.credentials {
+		if err := fact.credentials[i].IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		k := fact.credentials[i].String()
+		if _, found := founds[k]; found {
+			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("credential %v", k)))
+		}
+
+		founds[k] = struct{}{}
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact IndexCredentialsFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact IndexCredentialsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact IndexCredentialsFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact IndexCredentialsFact) Credentials() []types.CredentialRef {
+	return fact.credentials
+}
+
+func (fact IndexCredentialsFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact IndexCredentialsFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type IndexCredentials struct {
+	common.BaseOperation
+}
+
+func NewIndexCredentials(fact IndexCredentialsFact) IndexCredentials {
+	return IndexCredentials{BaseOperation: common.NewBaseOperation(IndexCredentialsHint, fact)}
+}
@@ -0,0 +1,50 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *IndexCredentialsFact) unpack(enc encoder.Encoder,
+	sAdr, cAdr string,
+	bCredentials []byte,
+	cid string,
+) error {
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	hrs, err := enc.DecodeSlice(bCredentials)
+	if err != nil {
+		return err
+	}
+
+	refs := make([]types.CredentialRef, len(hrs))
+	for i := range hrs {
+		j, ok := hrs[i].(types.CredentialRef)
+		if !ok {
+			return common.ErrTypeMismatch.Wrap(errors.Errorf("expected %T, not %T", types.CredentialRef{}, hrs[i]))
+		}
+
+		refs[i] = j
+	}
+	fact.credentials = refs
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package credential
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type IndexCredentialsFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner       base.Address             `json:"sender"`
+	Contract    base.Address             `json:"contract"`
+	Credentials []types.CredentialRef    `json:"credentials"`
+	Currency    currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact IndexCredentialsFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(IndexCredentialsFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		Credentials:           fact.credentials,
+		Currency:              fact.currency,
+	})
+}
+
+type IndexCredentialsFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner       string          `json:"sender"`
+	Contract    string          `json:"contract"`
+	Credentials json.RawMessage `json:"credentials"`
+	Currency    string          `json:"currency"`
+}
+
+func (fact *IndexCredentialsFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf IndexCredentialsFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.Credentials,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type IndexCredentialsMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op IndexCredentials) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(IndexCredentialsMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *IndexCredentials) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var indexCredentialsProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(IndexCredentialsProcessor)
+	},
+}
+
+func (IndexCredentials) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type IndexCredentialsProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewIndexCredentialsProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new IndexCredentialsProcessor")
+
+		nopp := indexCredentialsProcessorPool.Get()
+		opp, ok := nopp.(*IndexCredentialsProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected IndexCredentialsProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *IndexCredentialsProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(IndexCredentialsFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", IndexCredentialsFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if _, _, aErr, cErr := currencystate.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: sender %v is contract account", cErr, fact.Sender())), nil
+	}
+
+	_, cSt, aErr, cErr := currencystate.ExistsCAccount(fact.Contract(), "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", cErr)), nil
+	}
+
+	ca, err := extensioncurrency.LoadCAStateValue(cSt)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if !ca.Owner().Equal(fact.Sender()) {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v is not owner of contract account %v", fact.Sender(), fact.Contract())), nil
+	}
+
+	design, err := serviceDesign(fact.Contract(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if design.Closed() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMValueInvalid).
+				Errorf("credential service of contract account %v closed at height %v",
+					fact.Contract(), design.ClosedAt())), nil
+	}
+
+	if n := design.Policy().UnindexedCount(); uint64(len(fact.Credentials())) > n {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMValueInvalid).
+				Errorf("%d credentials to index in contract account %v, but %d unindexed",
+					len(fact.Credentials()), fact.Contract(), n)), nil
+	}
+
+	for _, ref := range fact.Credentials() {
+		cv, err := existsCredential(fact.Contract(), ref.TemplateID(), ref.CredentialID(), getStateFunc)
+		if err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Errorf("%v", err)), nil
+		}
+
+		if cv.Status.IsTerminated() {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Wrap(common.ErrMValueInvalid).
+					Errorf("already %v credential %v for template %v in contract account %v",
+						cv.Status, ref.CredentialID(), ref.TemplateID(), fact.Contract())), nil
+		}
+
+		refs, err := credentialIndex(
+			state.StateKeyHolderCredentials(fact.Contract(), cv.Credential.Holder()), getStateFunc)
+		if err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Errorf("%v", err)), nil
+		}
+
+		if indexOfCredentialRef(refs, ref) >= 0 {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Wrap(common.ErrMValueInvalid).
+					Errorf("credential %v for template %v already indexed in contract account %v",
+						ref.CredentialID(), ref.TemplateID(), fact.Contract())), nil
+		}
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *IndexCredentialsProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process IndexCredentials")
+
+	fact, ok := op.Fact().(IndexCredentialsFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected IndexCredentialsFact, not %T", op.Fact())
+	}
+
+	stats, err := newHolderStats(fact.Contract(), opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"credential design value not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	if err := stats.migrate(getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	for _, ref := range fact.Credentials() {
+		cv, err := existsCredential(fact.Contract(), ref.TemplateID(), ref.CredentialID(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+
+		if err := stats.backfill(cv.Credential.Holder(), ref, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+
+		template, err := credentialTemplate(fact.Contract(), cv.Credential, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+
+		if template.UniqueValue() {
+			if err := stats.addValue(ref, cv.Credential.Value(), getStateFunc); err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+			}
+		}
+	}
+
+	sts, err := stats.states()
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	feeSts, rErr, err := processCredentialItemsFee(getStateFunc, fact.Sender(), []CredentialItem{fact})
+	if rErr != nil || err != nil {
+		return nil, rErr, err
+	}
+
+	return append(sts, feeSts...), nil, nil
+}
+
+func (opp *IndexCredentialsProcessor) Close() error {
+	indexCredentialsProcessorPool.Put(opp)
+
+	return nil
+}
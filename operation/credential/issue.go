@@ -89,7 +89,7 @@ func (fact IssueFact) IsValid(b []byte) error {
 			return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
 		}
 
-		k := fmt.Sprintf("%s-%s", it.contract, it.credentialID)
+		k := fmt.Sprintf("%s-%s-%s", it.contract, it.templateID, it.credentialID)
 
 		if _, found := founds[k]; found {
 			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("credential id %v for template %v in contract account %v", it.CredentialID(), it.TemplateID(), it.Contract())))
@@ -16,15 +16,16 @@ var IssueItemHint = hint.MustNewHint("mitum-credential-issue-item-v0.0.1")
 
 type IssueItem struct {
 	hint.BaseHinter
-	contract     base.Address
-	holder       base.Address
-	templateID   string
-	credentialID string
-	value        string
-	validFrom    uint64
-	validUntil   uint64
-	did          string
-	currency     crcytypes.CurrencyID
+	contract         base.Address
+	holder           base.Address
+	templateID       string
+	credentialID     string
+	value            string
+	validFrom        uint64
+	validUntil       uint64
+	did              string
+	templateContract base.Address
+	currency         crcytypes.CurrencyID
 }
 
 func NewIssueItem(
@@ -36,23 +37,30 @@ func NewIssueItem(
 	validFrom uint64,
 	validUntil uint64,
 	did string,
+	templateContract base.Address,
 	currency crcytypes.CurrencyID,
 ) IssueItem {
 	return IssueItem{
-		BaseHinter:   hint.NewBaseHinter(IssueItemHint),
-		contract:     contract,
-		holder:       holder,
-		templateID:   templateID,
-		credentialID: credentialID,
-		value:        value,
-		validFrom:    validFrom,
-		validUntil:   validUntil,
-		did:          did,
-		currency:     currency,
+		BaseHinter:       hint.NewBaseHinter(IssueItemHint),
+		contract:         contract,
+		holder:           holder,
+		templateID:       templateID,
+		credentialID:     credentialID,
+		value:            value,
+		validFrom:        validFrom,
+		validUntil:       validUntil,
+		did:              did,
+		templateContract: templateContract,
+		currency:         currency,
 	}
 }
 
 func (it IssueItem) Bytes() []byte {
+	var tb []byte
+	if it.templateContract != nil {
+		tb = it.templateContract.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		it.contract.Bytes(),
 		it.holder.Bytes(),
@@ -62,6 +70,7 @@ func (it IssueItem) Bytes() []byte {
 		util.Uint64ToBytes(it.validFrom),
 		util.Uint64ToBytes(it.validUntil),
 		[]byte(it.did),
+		tb,
 		it.currency.Bytes(),
 	)
 }
@@ -76,6 +85,20 @@ func (it IssueItem) IsValid([]byte) error {
 		return common.ErrItemInvalid.Wrap(err)
 	}
 
+	if it.templateContract != nil {
+		if err := it.templateContract.IsValid(nil); err != nil {
+			return common.ErrItemInvalid.Wrap(err)
+		}
+
+		if it.templateContract.Equal(it.contract) {
+			return common.ErrItemInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("template contract address is same with contract, %q", it.contract)))
+		}
+
+		if it.templateContract.Equal(it.holder) {
+			return common.ErrItemInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("template contract address is same with holder, %q", it.holder)))
+		}
+	}
+
 	if it.contract.Equal(it.holder) {
 		return common.ErrItemInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("contract address is same with holder, %q", it.holder)))
 	}
@@ -143,6 +166,21 @@ func (it IssueItem) DID() string {
 	return it.did
 }
 
+// TemplateContract returns the contract account which published the shared
+// template. nil means the template of the issuing contract account.
+func (it IssueItem) TemplateContract() base.Address {
+	return it.templateContract
+}
+
+// TemplateOwner returns the contract account which holds the template state.
+func (it IssueItem) TemplateOwner() base.Address {
+	if it.templateContract != nil {
+		return it.templateContract
+	}
+
+	return it.contract
+}
+
 func (it IssueItem) Currency() crcytypes.CurrencyID {
 	return it.currency
 }
@@ -153,5 +191,9 @@ func (it IssueItem) Addresses() []base.Address {
 	ad[0] = it.contract
 	ad[1] = it.holder
 
+	if it.templateContract != nil {
+		ad = append(ad, it.templateContract)
+	}
+
 	return ad
 }
@@ -8,33 +8,38 @@ import (
 )
 
 func (it IssueItem) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":         it.Hint().String(),
-			"contract":      it.contract,
-			"holder":        it.holder,
-			"template_id":   it.templateID,
-			"credential_id": it.credentialID,
-			"value":         it.value,
-			"valid_from":    it.validFrom,
-			"valid_until":   it.validUntil,
-			"did":           it.did,
-			"currency":      it.currency,
-		},
-	)
+	m := bson.M{
+		"_hint":         it.Hint().String(),
+		"contract":      it.contract,
+		"holder":        it.holder,
+		"template_id":   it.templateID,
+		"credential_id": it.credentialID,
+		"value":         it.value,
+		"valid_from":    it.validFrom,
+		"valid_until":   it.validUntil,
+		"did":           it.did,
+		"currency":      it.currency,
+	}
+
+	if it.templateContract != nil {
+		m["template_contract"] = it.templateContract
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type IssueItemBSONUnmarshaler struct {
-	Hint         string `bson:"_hint"`
-	Contract     string `bson:"contract"`
-	Holder       string `bson:"holder"`
-	TemplateID   string `bson:"template_id"`
-	CredentialID string `bson:"credential_id"`
-	Value        string `bson:"value"`
-	ValidFrom    uint64 `bson:"valid_from"`
-	ValidUntil   uint64 `bson:"valid_until"`
-	DID          string `bson:"did"`
-	Currency     string `bson:"currency"`
+	Hint             string `bson:"_hint"`
+	Contract         string `bson:"contract"`
+	Holder           string `bson:"holder"`
+	TemplateID       string `bson:"template_id"`
+	CredentialID     string `bson:"credential_id"`
+	Value            string `bson:"value"`
+	ValidFrom        uint64 `bson:"valid_from"`
+	ValidUntil       uint64 `bson:"valid_until"`
+	DID              string `bson:"did"`
+	TemplateContract string `bson:"template_contract"`
+	Currency         string `bson:"currency"`
 }
 
 func (it *IssueItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -57,6 +62,7 @@ func (it *IssueItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		uit.ValidFrom,
 		uit.ValidUntil,
 		uit.DID,
+		uit.TemplateContract,
 		uit.Currency,
 	); err != nil {
 		return common.DecorateError(err, common.ErrDecodeBson, *it)
@@ -12,7 +12,7 @@ func (it *IssueItem) unpack(enc encoder.Encoder, ht hint.Hint,
 	id string,
 	val string,
 	vFrom, vUntil uint64,
-	did, cid string,
+	did, tcAdr, cid string,
 ) error {
 	it.BaseHinter = hint.NewBaseHinter(ht)
 	it.credentialID = id
@@ -34,6 +34,14 @@ func (it *IssueItem) unpack(enc encoder.Encoder, ht hint.Hint,
 		it.holder = a
 	}
 
+	if len(tcAdr) > 0 {
+		a, err := base.DecodeAddress(tcAdr, enc)
+		if err != nil {
+			return err
+		}
+		it.templateContract = a
+	}
+
 	it.templateID = tmplID
 	it.validFrom = vFrom
 	it.validUntil = vUntil
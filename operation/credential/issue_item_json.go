@@ -11,43 +11,46 @@ import (
 
 type IssueItemJSONMarshaler struct {
 	hint.BaseHinter
-	Contract     base.Address             `json:"contract"`
-	Holder       base.Address             `json:"holder"`
-	TemplateID   string                   `json:"template_id"`
-	CredentialID string                   `json:"credential_id"`
-	Value        string                   `json:"value"`
-	ValidFrom    uint64                   `json:"valid_from"`
-	ValidUntil   uint64                   `json:"valid_until"`
-	DID          string                   `json:"did"`
-	Currency     currencytypes.CurrencyID `json:"currency"`
+	Contract         base.Address             `json:"contract"`
+	Holder           base.Address             `json:"holder"`
+	TemplateID       string                   `json:"template_id"`
+	CredentialID     string                   `json:"credential_id"`
+	Value            string                   `json:"value"`
+	ValidFrom        uint64                   `json:"valid_from"`
+	ValidUntil       uint64                   `json:"valid_until"`
+	DID              string                   `json:"did"`
+	TemplateContract base.Address             `json:"template_contract,omitempty"`
+	Currency         currencytypes.CurrencyID `json:"currency"`
 }
 
 func (it IssueItem) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(IssueItemJSONMarshaler{
-		BaseHinter:   it.BaseHinter,
-		Contract:     it.contract,
-		Holder:       it.holder,
-		TemplateID:   it.templateID,
-		CredentialID: it.credentialID,
-		Value:        it.value,
-		ValidFrom:    it.validFrom,
-		ValidUntil:   it.validUntil,
-		DID:          it.did,
-		Currency:     it.currency,
+		BaseHinter:       it.BaseHinter,
+		Contract:         it.contract,
+		Holder:           it.holder,
+		TemplateID:       it.templateID,
+		CredentialID:     it.credentialID,
+		Value:            it.value,
+		ValidFrom:        it.validFrom,
+		ValidUntil:       it.validUntil,
+		DID:              it.did,
+		TemplateContract: it.templateContract,
+		Currency:         it.currency,
 	})
 }
 
 type IssueItemJSONUnMarshaler struct {
-	Hint         hint.Hint `json:"_hint"`
-	Contract     string    `json:"contract"`
-	Holder       string    `json:"holder"`
-	TemplateID   string    `json:"template_id"`
-	CredentialID string    `json:"credential_id"`
-	Value        string    `json:"value"`
-	ValidFrom    uint64    `json:"valid_from"`
-	ValidUntil   uint64    `json:"valid_until"`
-	DID          string    `json:"did"`
-	Currency     string    `json:"currency"`
+	Hint             hint.Hint `json:"_hint"`
+	Contract         string    `json:"contract"`
+	Holder           string    `json:"holder"`
+	TemplateID       string    `json:"template_id"`
+	CredentialID     string    `json:"credential_id"`
+	Value            string    `json:"value"`
+	ValidFrom        uint64    `json:"valid_from"`
+	ValidUntil       uint64    `json:"valid_until"`
+	DID              string    `json:"did"`
+	TemplateContract string    `json:"template_contract"`
+	Currency         string    `json:"currency"`
 }
 
 func (it *IssueItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -66,6 +69,7 @@ func (it *IssueItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		uit.ValidFrom,
 		uit.ValidUntil,
 		uit.DID,
+		uit.TemplateContract,
 		uit.Currency,
 	); err != nil {
 		return common.DecorateError(err, common.ErrDecodeJson, *it)
@@ -0,0 +1,91 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+// TestIssueMergedInBlock processes the issuances of the same credential
+// service in one block, and merges their states like the block writer; the
+// counters of the design and the quota usages count every issuance.
+func TestIssueMergedInBlock(t *testing.T) {
+	r := newRevokeAllTest(t, 0)
+	ca := r.contract[0].Address()
+	height := base.Height(10)
+
+	mergers := map[string]base.StateValueMerger{}
+
+	for i := range r.holders {
+		items := make([]IssueItem, 1)
+
+		ip := NewTestIssueProcessor(r.TestProcessor)
+		ip.SetTemplate("t1", fmt.Sprintf("credential%d", i), fmt.Sprintf("value%d", i), 1735689600, 1767225600, "did").
+			MakeItem(r.contract[0], r.holders[i], r.GenesisCurrency, items).
+			MakeOperation(r.sender[0].Address(), r.sender[0].Priv(), items)
+
+		opp, err := NewIssueProcessor()(height, r.GetStateFunc, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sts, reason, err := opp.Process(context.Background(), ip.Op, r.GetStateFunc)
+		switch {
+		case err != nil:
+			t.Fatal(err)
+		case reason != nil:
+			t.Fatal(reason)
+		}
+
+		for _, stv := range sts {
+			m, found := mergers[stv.Key()]
+			if !found {
+				st, _, err := r.GetStateFunc(stv.Key())
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				m = stv.Merger(height, st)
+				mergers[stv.Key()] = m
+			}
+
+			if err := m.Merge(stv.Value(), valuehash.RandomSHA256()); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	closeValue := func(key string) base.StateValue {
+		m, found := mergers[key]
+		if !found {
+			t.Fatalf("state not merged, %s", key)
+		}
+
+		st, err := m.CloseValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return st.Value()
+	}
+
+	policy := closeValue(state.StateKeyDesign(ca)).(state.DesignStateValue).Design.Policy() //nolint:forcetypeassert //...
+	if policy.HolderCount() != 2 || policy.CredentialCount() != 2 {
+		t.Fatalf("%d holders and %d credentials counted, not 2 and 2", policy.HolderCount(), policy.CredentialCount())
+	}
+
+	for _, k := range []string{state.StateKeyServiceQuotaUsage(ca), state.StateKeyTemplateQuotaUsage(ca, "t1")} {
+		if usage := closeValue(k).(state.QuotaUsageStateValue); usage.Issued != 2 { //nolint:forcetypeassert //...
+			t.Fatalf("%d credentials counted in quota usage, %s, not 2", usage.Issued, k)
+		}
+	}
+
+	page := closeValue(state.StateKeyTemplateCredentialPage(ca, "t1", height)).(state.CredentialPageStateValue) //nolint:forcetypeassert //...
+	if len(page.Credentials) != 2 {
+		t.Fatalf("%d credentials in credential page, not 2", len(page.Credentials))
+	}
+}
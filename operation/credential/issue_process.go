@@ -4,8 +4,6 @@ import (
 	"context"
 	"sync"
 
-	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
-
 	"github.com/ProtoconNet/mitum-credential/state"
 	"github.com/ProtoconNet/mitum-credential/types"
 	"github.com/ProtoconNet/mitum-currency/v3/common"
@@ -37,87 +35,90 @@ func (Issue) Process(
 }
 
 type IssueItemProcessor struct {
-	h               util.Hash
-	sender          base.Address
-	item            IssueItem
-	credentialCount *uint64
-	holders         *[]types.Holder
+	h      util.Hash
+	sender base.Address
+	item   IssueItem
+	height base.Height
+	stats  *holderStats
+	// supersedes is the ID of the credential superseded by the credential of
+	// item; empty unless the item is reissued.
+	supersedes string
+	// batch keeps the credentials issued by the previous items of the
+	// operation while the items are preprocessed.
+	batch *issueBatch
 }
 
-func (ipp *IssueItemProcessor) PreProcess(
-	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
-) error {
-	e := util.StringError("preprocess IssueItemProcessor")
-	it := ipp.item
+// issueBatch keeps the credentials issued by the items of an operation, which
+// are not in the states while the items are preprocessed.
+type issueBatch struct {
+	counts  map[string]uint64
+	holders map[string]base.Address
+}
 
-	if err := it.IsValid(nil); err != nil {
-		return e.Wrap(err)
+func newIssueBatch() *issueBatch {
+	return &issueBatch{
+		counts:  map[string]uint64{},
+		holders: map[string]base.Address{},
 	}
+}
 
-	if err := currencystate.CheckExistsState(statecurrency.DesignStateKey(it.Currency()), getStateFunc); err != nil {
-		return e.Wrap(common.ErrCurrencyNF.Wrap(errors.Errorf("currency id %v", it.Currency())))
+func (b *issueBatch) add(it IssueItem) {
+	if b == nil {
+		return
 	}
 
-	if _, _, _, cErr := currencystate.ExistsCAccount(
-		it.Holder(), "holder", true, false, getStateFunc); cErr != nil {
-		return e.Wrap(common.ErrCAccountNA.Wrap(errors.Errorf("%v: holder %v is contract account", cErr, it.Holder())))
+	b.counts[issueBatchCountKey(it.Contract(), it.Holder(), it.TemplateID())]++
+	b.holders[state.StateKeyValueCredentials(it.Contract(), it.TemplateID(), it.Value())] = it.Holder()
+}
+
+// count returns the number of the credentials of templateID issued to holder.
+func (b *issueBatch) count(contract, holder base.Address, templateID string) uint64 {
+	if b == nil {
+		return 0
 	}
 
-	_, cSt, aErr, cErr := currencystate.ExistsCAccount(it.Contract(), "contract", true, true, getStateFunc)
-	if aErr != nil {
-		return e.Wrap(aErr)
-	} else if cErr != nil {
-		return e.Wrap(cErr)
+	return b.counts[issueBatchCountKey(contract, holder, templateID)]
+}
+
+// holder returns the holder of the credential of value of templateID; nil if
+// not issued.
+func (b *issueBatch) holder(contract base.Address, templateID, value string) base.Address {
+	if b == nil {
+		return nil
 	}
 
-	_, err := extensioncurrency.CheckCAAuthFromState(cSt, ipp.sender)
+	return b.holders[state.StateKeyValueCredentials(contract, templateID, value)]
+}
+
+func issueBatchCountKey(contract, holder base.Address, templateID string) string {
+	return state.StateKeyHolderCredentials(contract, holder) + ":" + templateID
+}
+
+func (ipp *IssueItemProcessor) PreProcess(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	e := util.StringError("preprocess IssueItemProcessor")
+	it := ipp.item
+
+	template, err := checkIssuable(ipp.sender, it, ipp.height, getStateFunc)
 	if err != nil {
 		return e.Wrap(err)
 	}
 
-	if st, err := currencystate.ExistsState(state.StateKeyDesign(it.Contract()), "design", getStateFunc); err != nil {
+	if template.ConsentRequired() {
 		return e.Wrap(
-			common.ErrServiceNF.Errorf("credential design state for contract account %v", it.Contract()))
-	} else if de, err := state.StateDesignValue(st); err != nil {
-		return e.Wrap(
-			common.ErrServiceNF.Errorf("credential design state value for contract account %v", it.Contract()))
-	} else {
-		if err := de.IsValid(nil); err != nil {
-			return e.Wrap(err)
-		}
-		for i, v := range de.Policy().TemplateIDs() {
-			if it.templateID == v {
-				break
-			}
-			if i == len(de.Policy().TemplateIDs())-1 {
-				return e.Wrap(
-					common.ErrValueInvalid.Errorf(
-						"templateID %v not registered in contract account %v", it.TemplateID(), it.Contract()))
-			}
-		}
+			common.ErrValueInvalid.Errorf(
+				"template %v in contract account %v requires consent of holder; offer credential instead",
+				it.TemplateID(), it.TemplateOwner()))
 	}
 
-	switch st, found, err := getStateFunc(state.StateKeyCredential(it.Contract(),
-		it.TemplateID(),
-		it.CredentialID())); {
-	case err != nil:
-		return e.Wrap(common.ErrStateNF.Errorf(
-			"credential %v for template id %v in contract account %v", it.CredentialID(), it.TemplateID(), it.Contract()))
-	case !found:
-	default:
-		if credential, isActive, err := state.StateCredentialValue(st); err != nil {
-			return e.Wrap(
-				common.ErrStateValInvalid.Errorf(
-					"credential %v for template id %v in contract account %v",
-					it.CredentialID(), it.TemplateID(), it.Contract()))
-		} else if isActive {
-			return e.Wrap(
-				common.ErrValueInvalid.Errorf(
-					"credential %v for template %v is already issued to holder %v in contract account %v",
-					it.CredentialID(), it.TemplateID(), credential.Holder(), it.Contract()))
-		}
+	if err := checkIssuanceLimits(
+		it.Contract(), it.Holder(), template, it.Value(), ipp.supersedes, ipp.batch, getStateFunc); err != nil {
+		return e.Wrap(err)
 	}
 
+	ipp.batch.add(it)
+
 	return nil
 }
 
@@ -126,7 +127,9 @@ func (ipp *IssueItemProcessor) Process(
 ) ([]base.StateMergeValue, error) {
 	it := ipp.item
 
-	*ipp.credentialCount++
+	if err := ipp.stats.add(it.Holder(), types.NewCredentialRef(it.TemplateID(), it.CredentialID()), getStateFunc); err != nil {
+		return nil, err
+	}
 
 	var sts []base.StateMergeValue
 
@@ -137,14 +140,35 @@ func (ipp *IssueItemProcessor) Process(
 		sts = append(sts, smv)
 	}
 
-	credential := types.NewCredential(it.Holder(), it.TemplateID(), it.CredentialID(), it.Value(), it.ValidFrom(), it.ValidUntil(), it.DID())
+	credential := types.NewCredential(
+		it.Holder(), it.TemplateID(), it.CredentialID(), it.Value(), it.ValidFrom(), it.ValidUntil(), it.DID(),
+	).SetTemplateContract(it.TemplateContract())
 	if err := credential.IsValid(nil); err != nil {
 		return nil, err
 	}
 
+	template, err := existsTemplate(it.TemplateOwner(), it.TemplateID(), getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if template.UniqueValue() {
+		if err := ipp.stats.addValue(
+			types.NewCredentialRef(it.TemplateID(), it.CredentialID()), it.Value(), getStateFunc); err != nil {
+			return nil, err
+		}
+	}
+
+	// NOTE the credential of template with auditors waits for the approvals
+	// of auditors by AuditCredential.
+	status := types.CredentialStatusActive
+	if template.AuditRequired() {
+		status = types.CredentialStatusPending
+	}
+
 	sts = append(sts, currencystate.NewStateMergeValue(
 		state.StateKeyCredential(it.Contract(), it.TemplateID(), it.CredentialID()),
-		state.NewCredentialStateValue(credential, true),
+		state.NewCredentialStateValue(credential, status).SetSupersedes(ipp.supersedes),
 	))
 
 	sts = append(sts, currencystate.NewStateMergeValue(
@@ -152,30 +176,126 @@ func (ipp *IssueItemProcessor) Process(
 		state.NewHolderDIDStateValue(it.DID()),
 	))
 
-	if len(*ipp.holders) == 0 {
-		*ipp.holders = append(*ipp.holders, types.NewHolder(it.Holder(), 1))
+	return sts, nil
+}
+
+// checkIssuable checks that sender can issue the credential of it at height and
+// returns the template of the credential.
+func checkIssuable(
+	sender base.Address, it IssueItem, height base.Height, getStateFunc base.GetStateFunc,
+) (types.Template, error) {
+	if err := it.IsValid(nil); err != nil {
+		return types.Template{}, err
+	}
+
+	if err := currencystate.CheckExistsState(statecurrency.DesignStateKey(it.Currency()), getStateFunc); err != nil {
+		return types.Template{}, common.ErrCurrencyNF.Wrap(errors.Errorf("currency id %v", it.Currency()))
+	}
+
+	if _, _, _, cErr := currencystate.ExistsCAccount(
+		it.Holder(), "holder", true, false, getStateFunc); cErr != nil {
+		return types.Template{}, common.ErrCAccountNA.Wrap(errors.Errorf("%v: holder %v is contract account", cErr, it.Holder()))
+	}
+
+	_, cSt, aErr, cErr := currencystate.ExistsCAccount(it.Contract(), "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return types.Template{}, aErr
+	} else if cErr != nil {
+		return types.Template{}, cErr
+	}
+
+	if err := checkTemplateRole(
+		cSt, sender, it.Contract(), it.TemplateID(), types.RoleIssuer, getStateFunc); err != nil {
+		return types.Template{}, err
+	}
+
+	if err := checkServiceOpen(it.Contract(), getStateFunc); err != nil {
+		return types.Template{}, err
+	}
+
+	var registered bool
+	if st, err := currencystate.ExistsState(state.StateKeyDesign(it.Contract()), "design", getStateFunc); err != nil {
+		return types.Template{}, common.ErrServiceNF.Errorf("credential design state for contract account %v", it.Contract())
+	} else if de, err := state.StateDesignValue(st); err != nil {
+		return types.Template{}, common.ErrServiceNF.Errorf("credential design state value for contract account %v", it.Contract())
 	} else {
-		for i, h := range *ipp.holders {
-			if h.Address().Equal(it.Holder()) {
-				(*ipp.holders)[i] = types.NewHolder(h.Address(), h.CredentialCount()+1)
+		if err := de.IsValid(nil); err != nil {
+			return types.Template{}, err
+		}
+		for _, v := range de.Policy().TemplateIDs() {
+			if it.templateID == v {
+				registered = true
+
 				break
 			}
+		}
+	}
 
-			if i == len(*ipp.holders)-1 {
-				*ipp.holders = append(*ipp.holders, types.NewHolder(it.Holder(), 1))
-			}
+	var template types.Template
+	var err error
+	switch {
+	case it.TemplateContract() == nil && !registered:
+		return types.Template{}, common.ErrValueInvalid.Errorf(
+			"templateID %v not registered in contract account %v", it.TemplateID(), it.Contract())
+	case it.TemplateContract() == nil:
+		if template, err = existsTemplate(it.Contract(), it.TemplateID(), getStateFunc); err != nil {
+			return types.Template{}, err
+		}
+	case registered:
+		// NOTE the credentials of the own template and the shared template
+		// of the same templateID would share the credential states.
+		return types.Template{}, common.ErrValueInvalid.Errorf(
+			"templateID %v of shared template is already registered in contract account %v",
+			it.TemplateID(), it.Contract())
+	default:
+		if template, err = checkSharedTemplate(
+			it.Contract(), it.TemplateContract(), it.TemplateID(), getStateFunc); err != nil {
+			return types.Template{}, err
 		}
 	}
 
-	return sts, nil
+	if template.Deprecated() {
+		return types.Template{}, common.ErrValueInvalid.Errorf(
+			"deprecated template %v in contract account %v", it.TemplateID(), it.TemplateOwner())
+	}
+
+	if err := template.CheckServiceHeight(height); err != nil {
+		return types.Template{}, err
+	}
+
+	if err := template.CheckServicePeriod(it.ValidFrom(), it.ValidUntil()); err != nil {
+		return types.Template{}, err
+	}
+
+	if err := template.ValidateValue(it.Value()); err != nil {
+		return types.Template{}, errors.Errorf("credential value does not conform to schema of template %v; %v", it.TemplateID(), err)
+	}
+
+	if err := checkPrerequisites(
+		it.Contract(), it.Holder(), template, it.ValidFrom(), it.ValidUntil(), getStateFunc); err != nil {
+		return types.Template{}, err
+	}
+
+	if err := checkCredentialIssuable(
+		it.Contract(), it.TemplateContract(), it.TemplateID(), it.CredentialID(), getStateFunc); err != nil {
+		return types.Template{}, err
+	}
+
+	if err := checkNoOpenOffer(it.Contract(), it.TemplateID(), it.CredentialID(), height, getStateFunc); err != nil {
+		return types.Template{}, err
+	}
+
+	return template, nil
 }
 
 func (ipp *IssueItemProcessor) Close() {
 	ipp.h = nil
 	ipp.sender = nil
 	ipp.item = IssueItem{}
-	ipp.credentialCount = nil
-	ipp.holders = nil
+	ipp.height = 0
+	ipp.stats = nil
+	ipp.supersedes = ""
+	ipp.batch = nil
 
 	issueItemProcessorPool.Put(ipp)
 }
@@ -246,6 +366,8 @@ func (opp *IssueProcessor) PreProcess(
 				Errorf("%v", err)), nil
 	}
 
+	batch := newIssueBatch()
+
 	for _, it := range fact.Items() {
 		ip := issueItemProcessorPool.Get()
 		ipc, ok := ip.(*IssueItemProcessor)
@@ -257,8 +379,9 @@ func (opp *IssueProcessor) PreProcess(
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = it
-		ipc.credentialCount = nil
-		ipc.holders = nil
+		ipc.height = opp.Height()
+		ipc.stats = nil
+		ipc.batch = batch
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError(
@@ -278,42 +401,45 @@ func (opp *IssueProcessor) Process( // nolint:dupl
 ) {
 	e := util.StringError("failed to process Issue")
 
-	fact, _ := op.Fact().(IssueFact)
-	designs := map[string]types.Design{}
-	counters := map[string]*uint64{}
-	holders := map[string]*[]types.Holder{}
+	fact, ok := op.Fact().(IssueFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected IssueFact, not %T", op.Fact())
+	}
+
+	stats := map[string]*holderStats{}
 
 	for _, it := range fact.Items() {
 		k := state.StateKeyDesign(it.Contract())
 
-		if _, found := counters[k]; found {
+		if _, found := stats[k]; found {
 			continue
 		}
 
-		st, _ := currencystate.ExistsState(k, "design", getStateFunc)
-
-		design, _ := state.StateDesignValue(st)
-		count := design.Policy().CredentialCount()
-		holder := design.Policy().Holders()
+		hs, err := newHolderStats(it.Contract(), opp.Height(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"credential design value not found, %s; %w", it.Contract(), err), nil
+		}
 
-		designs[k] = design
-		counters[k] = &count
-		holders[k] = &holder
+		stats[k] = hs
 	}
 
+	quotas := newIssuanceQuotas(opp.Height())
+
 	var sts []base.StateMergeValue // nolint:prealloc
 
 	for _, it := range fact.Items() {
+		if err := quotas.add(it.Contract(), it.TemplateOwner(), it.TemplateID(), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+
 		ip := issueItemProcessorPool.Get()
 		ipc, _ := ip.(*IssueItemProcessor)
 
-		k := state.StateKeyDesign(it.Contract())
-
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = it
-		ipc.credentialCount = counters[k]
-		ipc.holders = holders[k]
+		ipc.stats = stats[state.StateKeyDesign(it.Contract())]
 
 		st, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
@@ -325,70 +451,27 @@ func (opp *IssueProcessor) Process( // nolint:dupl
 		ipc.Close()
 	}
 
-	for k, de := range designs {
-		policy := types.NewPolicy(de.Policy().TemplateIDs(), *holders[k], *counters[k])
-		design := types.NewDesign(policy)
-		if err := design.IsValid(nil); err != nil {
-			return nil, base.NewBaseOperationProcessReasonError("invalid design, %s; %w", k, err), nil
+	for _, k := range sortedHolderStatsKeys(stats) {
+		st, err := stats[k].states()
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
 		}
 
-		sts = append(sts,
-			currencystate.NewStateMergeValue(
-				k,
-				state.NewDesignStateValue(design),
-			),
-		)
+		sts = append(sts, st...)
 	}
 
+	sts = append(sts, quotas.states()...)
+
 	items := make([]CredentialItem, len(fact.Items()))
 	for i := range fact.Items() {
 		items[i] = fact.Items()[i]
 	}
 
-	feeReceiverBalSts, required, err := calculateCredentialItemsFee(getStateFunc, items)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
-	}
-	sb, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
-	}
-
-	for cid := range sb {
-		v, ok := sb[cid].Value().(statecurrency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Errorf("expected BalanceStateValue, not %T", sb[cid].Value())
-		}
-
-		_, feeReceiverFound := feeReceiverBalSts[cid]
-
-		if feeReceiverFound && (sb[cid].Key() != feeReceiverBalSts[cid].Key()) {
-			stmv := common.NewBaseStateMergeValue(
-				sb[cid].Key(),
-				statecurrency.NewDeductBalanceStateValue(v.Amount.WithBig(required[cid][1])),
-				func(height base.Height, st base.State) base.StateValueMerger {
-					return statecurrency.NewBalanceStateValueMerger(height, sb[cid].Key(), cid, st)
-				},
-			)
-
-			r, ok := feeReceiverBalSts[cid].Value().(statecurrency.BalanceStateValue)
-			if !ok {
-				return nil, base.NewBaseOperationProcessReasonError("expected %T, not %T", statecurrency.BalanceStateValue{}, feeReceiverBalSts[cid].Value()), nil
-			}
-			sts = append(
-				sts,
-				common.NewBaseStateMergeValue(
-					feeReceiverBalSts[cid].Key(),
-					statecurrency.NewAddBalanceStateValue(r.Amount.WithBig(required[cid][1])),
-					func(height base.Height, st base.State) base.StateValueMerger {
-						return statecurrency.NewBalanceStateValueMerger(height, feeReceiverBalSts[cid].Key(), cid, st)
-					},
-				),
-			)
-
-			sts = append(sts, stmv)
-		}
+	feeSts, rErr, err := processCredentialItemsFee(getStateFunc, fact.Sender(), items)
+	if rErr != nil || err != nil {
+		return nil, rErr, err
 	}
+	sts = append(sts, feeSts...)
 
 	return sts, nil, nil
 }
@@ -444,3 +527,58 @@ func calculateCredentialItemsFee(getStateFunc base.GetStateFunc, items []Credent
 	return feeReceiveSts, required, nil
 
 }
+
+func processCredentialItemsFee(getStateFunc base.GetStateFunc, sender base.Address, items []CredentialItem) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process credential items fee")
+
+	feeReceiverBalSts, required, err := calculateCredentialItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
+	}
+	sb, err := currency.CheckEnoughBalance(sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for cid := range sb {
+		v, ok := sb[cid].Value().(statecurrency.BalanceStateValue)
+		if !ok {
+			return nil, nil, e.Errorf("expected BalanceStateValue, not %T", sb[cid].Value())
+		}
+
+		_, feeReceiverFound := feeReceiverBalSts[cid]
+
+		if feeReceiverFound && (sb[cid].Key() != feeReceiverBalSts[cid].Key()) {
+			stmv := common.NewBaseStateMergeValue(
+				sb[cid].Key(),
+				statecurrency.NewDeductBalanceStateValue(v.Amount.WithBig(required[cid][1])),
+				func(height base.Height, st base.State) base.StateValueMerger {
+					return statecurrency.NewBalanceStateValueMerger(height, sb[cid].Key(), cid, st)
+				},
+			)
+
+			r, ok := feeReceiverBalSts[cid].Value().(statecurrency.BalanceStateValue)
+			if !ok {
+				return nil, base.NewBaseOperationProcessReasonError("expected %T, not %T", statecurrency.BalanceStateValue{}, feeReceiverBalSts[cid].Value()), nil
+			}
+			sts = append(
+				sts,
+				common.NewBaseStateMergeValue(
+					feeReceiverBalSts[cid].Key(),
+					statecurrency.NewAddBalanceStateValue(r.Amount.WithBig(required[cid][1])),
+					func(height base.Height, st base.State) base.StateValueMerger {
+						return statecurrency.NewBalanceStateValueMerger(height, feeReceiverBalSts[cid].Key(), cid, st)
+					},
+				),
+			)
+
+			sts = append(sts, stmv)
+		}
+	}
+
+	return sts, nil, nil
+}
@@ -0,0 +1,99 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	OfferCredentialFactHint = hint.MustNewHint("mitum-credential-offer-credential-operation-fact-v0.0.1")
+	OfferCredentialHint     = hint.MustNewHint("mitum-credential-offer-credential-operation-v0.0.1")
+)
+
+// OfferCredentialFact offers the credential of item to its holder. The
+// credential is issued when the holder accepts the offer by AcceptCredential.
+type OfferCredentialFact struct {
+	base.BaseFact
+	sender base.Address
+	item   IssueItem
+}
+
+func NewOfferCredentialFact(token []byte, sender base.Address, item IssueItem) OfferCredentialFact {
+	bf := base.NewBaseFact(OfferCredentialFactHint, token)
+	fact := OfferCredentialFact{
+		BaseFact: bf,
+		sender:   sender,
+		item:     item,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact OfferCredentialFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact OfferCredentialFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact OfferCredentialFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.item.Bytes(),
+	)
+}
+
+func (fact OfferCredentialFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.item,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.item.Contract().Equal(fact.sender) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if fact.item.Holder().Equal(fact.sender) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with holder", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact OfferCredentialFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact OfferCredentialFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact OfferCredentialFact) Item() IssueItem {
+	return fact.item
+}
+
+func (fact OfferCredentialFact) Addresses() ([]base.Address, error) {
+	return append(fact.item.Addresses(), fact.sender), nil
+}
+
+type OfferCredential struct {
+	common.BaseOperation
+}
+
+func NewOfferCredential(fact OfferCredentialFact) OfferCredential {
+	return OfferCredential{BaseOperation: common.NewBaseOperation(OfferCredentialHint, fact)}
+}
@@ -0,0 +1,67 @@
+package credential
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type OfferCredentialFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address `json:"sender"`
+	Item   IssueItem    `json:"item"`
+}
+
+func (fact OfferCredentialFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OfferCredentialFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Item:                  fact.item,
+	})
+}
+
+type OfferCredentialFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Item   json.RawMessage `json:"item"`
+}
+
+func (fact *OfferCredentialFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf OfferCredentialFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Sender, uf.Item); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type OfferCredentialMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op OfferCredential) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OfferCredentialMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *OfferCredential) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	PauseServiceFactHint = hint.MustNewHint("mitum-credential-pause-service-operation-fact-v0.0.1")
+	PauseServiceHint     = hint.MustNewHint("mitum-credential-pause-service-operation-v0.0.1")
+)
+
+type PauseServiceFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	currency crcytypes.CurrencyID
+}
+
+func NewPauseServiceFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	currency crcytypes.CurrencyID,
+) PauseServiceFact {
+	bf := base.NewBaseFact(PauseServiceFactHint, token)
+	fact := PauseServiceFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact PauseServiceFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact PauseServiceFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact PauseServiceFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact PauseServiceFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact PauseServiceFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact PauseServiceFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact PauseServiceFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact PauseServiceFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact PauseServiceFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type PauseService struct {
+	common.BaseOperation
+}
+
+func NewPauseService(fact PauseServiceFact) PauseService {
+	return PauseService{BaseOperation: common.NewBaseOperation(PauseServiceHint, fact)}
+}
@@ -0,0 +1,127 @@
+package credential
+
+import (
+	"sort"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	cstate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// issuanceQuotas keeps the usages of the issuance quotas of the credential
+// services and of their templates while the items of an operation are
+// processed at height. The credentials issued by the operation are merged
+// with the ones of the other operations at the height, see
+// state.QuotaUsageStateValueMerger.
+type issuanceQuotas struct {
+	height base.Height
+	usages map[string]state.QuotaUsageStateValue
+	quotas map[string]types.Quota
+	issued map[string]uint64
+}
+
+func newIssuanceQuotas(height base.Height) *issuanceQuotas {
+	return &issuanceQuotas{
+		height: height,
+		usages: map[string]state.QuotaUsageStateValue{},
+		quotas: map[string]types.Quota{},
+		issued: map[string]uint64{},
+	}
+}
+
+// add counts the credential of templateID issued by contract against the
+// issuance quota of the credential service of contract and the issuance quota
+// of the template in templateOwner. The quota of shared template limits the
+// credentials issued by each credential service.
+func (iq *issuanceQuotas) add(
+	contract, templateOwner base.Address, templateID string, getStateFunc base.GetStateFunc,
+) error {
+	st, err := cstate.ExistsState(state.StateKeyDesign(contract), "design", getStateFunc)
+	if err != nil {
+		return common.ErrServiceNF.Errorf("credential design state for contract account %v", contract)
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return common.ErrServiceNF.Errorf("credential design state value for contract account %v", contract)
+	}
+
+	template, err := existsTemplate(templateOwner, templateID, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if err := iq.count(
+		state.StateKeyServiceQuotaUsage(contract), design.Quota(), getStateFunc,
+	); err != nil {
+		return common.ErrValOOR.Errorf("credential service of contract account %v; %v", contract, err)
+	}
+
+	if err := iq.count(
+		state.StateKeyTemplateQuotaUsage(contract, templateID), template.Quota(), getStateFunc,
+	); err != nil {
+		return common.ErrValOOR.Errorf("template %v in contract account %v; %v", templateID, contract, err)
+	}
+
+	return nil
+}
+
+func (iq *issuanceQuotas) count(key string, quota types.Quota, getStateFunc base.GetStateFunc) error {
+	usage, found := iq.usages[key]
+	if !found {
+		switch st, found, err := getStateFunc(key); {
+		case err != nil:
+			return common.ErrStateNF.Errorf("quota usage %v", key)
+		case !found:
+			usage = state.NewQuotaUsageStateValue(0, base.GenesisHeight, 0)
+		default:
+			if usage, err = state.StateQuotaUsageValue(st); err != nil {
+				return common.ErrStateValInvalid.Errorf("quota usage %v", key)
+			}
+		}
+	}
+
+	usage = usage.At(quota, iq.height)
+
+	if limit := quota.MaxCredentials(); limit > 0 && usage.Issued >= limit {
+		return errors.Errorf("issuance quota exceeded, %d credentials issued, max %d", usage.Issued, limit)
+	}
+
+	if limit := quota.MaxIssuances(); limit > 0 && usage.PeriodIssued >= limit {
+		return errors.Errorf(
+			"issuance rate limit exceeded, %d credentials issued from height %v, max %d per %d blocks",
+			usage.PeriodIssued, usage.PeriodStart, limit, quota.Period())
+	}
+
+	usage.Issued++
+	if quota.Period() > 0 {
+		usage.PeriodIssued++
+	}
+
+	iq.usages[key] = usage
+	iq.quotas[key] = quota
+	iq.issued[key]++
+
+	return nil
+}
+
+// states returns the state merge values adding the issued credentials to the
+// quota usages.
+func (iq *issuanceQuotas) states() []base.StateMergeValue {
+	keys := make([]string, 0, len(iq.issued))
+	for k := range iq.issued {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	sts := make([]base.StateMergeValue, len(keys))
+	for i, k := range keys {
+		sts[i] = state.NewQuotaUsageStateMergeValue(
+			k, state.NewAddQuotaUsageStateValue(iq.quotas[k], iq.height, iq.issued[k]))
+	}
+
+	return sts
+}
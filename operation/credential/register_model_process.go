@@ -147,9 +147,8 @@ func (opp *RegisterModelProcessor) Process(
 	}
 
 	var templates []string
-	var holders []credentialtypes.Holder
 
-	policy := credentialtypes.NewPolicy(templates, holders, 0)
+	policy := credentialtypes.NewPolicy(templates, 0, 0)
 	design := credentialtypes.NewDesign(policy)
 
 	var sts []base.StateMergeValue
@@ -0,0 +1,136 @@
+package credential
+
+import (
+	"fmt"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ReinstateFactHint = hint.MustNewHint("mitum-credential-reinstate-operation-fact-v0.0.1")
+	ReinstateHint     = hint.MustNewHint("mitum-credential-reinstate-operation-v0.0.1")
+)
+
+var MaxReinstateItems uint = 1000
+
+type ReinstateFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []ReinstateItem
+}
+
+func NewReinstateFact(token []byte, sender base.Address, items []ReinstateItem) ReinstateFact {
+	bf := base.NewBaseFact(ReinstateFactHint, token)
+	fact := ReinstateFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ReinstateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ReinstateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ReinstateFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact ReinstateFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("empty items")))
+	} else if n > int(MaxReinstateItems) {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("items, %d over max, %d", n, MaxReinstateItems)))
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, it := range fact.items {
+		if err := it.IsValid(nil); err != nil {
+			return err
+		}
+
+		if it.contract.Equal(fact.sender) {
+			return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+		}
+
+		k := fmt.Sprintf("%s-%s-%s", it.contract, it.templateID, it.credentialID)
+
+		if _, found := founds[k]; found {
+			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("credential id %v for template %v in contract account %v", it.CredentialID(), it.TemplateID(), it.Contract())))
+		}
+
+		founds[k] = struct{}{}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact ReinstateFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ReinstateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ReinstateFact) Items() []ReinstateItem {
+	return fact.items
+}
+
+func (fact ReinstateFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{}
+
+	adrMap := make(map[string]struct{})
+	for i := range fact.items {
+		for j := range fact.items[i].Addresses() {
+			if _, found := adrMap[fact.items[i].Addresses()[j].String()]; !found {
+				adrMap[fact.items[i].Addresses()[j].String()] = struct{}{}
+				as = append(as, fact.items[i].Addresses()[j])
+			}
+		}
+	}
+	as = append(as, fact.sender)
+
+	return as, nil
+}
+
+type Reinstate struct {
+	common.BaseOperation
+}
+
+func NewReinstate(fact ReinstateFact) Reinstate {
+	return Reinstate{BaseOperation: common.NewBaseOperation(ReinstateHint, fact)}
+}
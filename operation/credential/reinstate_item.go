@@ -0,0 +1,112 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var ReinstateItemHint = hint.MustNewHint("mitum-credential-reinstate-item-v0.0.1")
+
+type ReinstateItem struct {
+	hint.BaseHinter
+	contract     base.Address
+	holder       base.Address
+	templateID   string
+	credentialID string
+	currency     crcytypes.CurrencyID
+}
+
+func NewReinstateItem(
+	contract base.Address,
+	holder base.Address,
+	templateID, credentialID string,
+	currency crcytypes.CurrencyID,
+) ReinstateItem {
+	return ReinstateItem{
+		BaseHinter:   hint.NewBaseHinter(ReinstateItemHint),
+		contract:     contract,
+		holder:       holder,
+		templateID:   templateID,
+		credentialID: credentialID,
+		currency:     currency,
+	}
+}
+
+func (it ReinstateItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.contract.Bytes(),
+		it.holder.Bytes(),
+		[]byte(it.templateID),
+		[]byte(it.credentialID),
+		it.currency.Bytes(),
+	)
+}
+
+func (it ReinstateItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.contract,
+		it.holder,
+		it.currency,
+	); err != nil {
+		return err
+	}
+
+	if it.contract.Equal(it.holder) {
+		return common.ErrItemInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("contract address is same with holder, %q", it.holder)))
+	}
+
+	if l := utf8.RuneCountInString(it.templateID); l < 1 || l > types.MaxLengthTemplateID {
+		return common.ErrItemInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d", types.MaxLengthTemplateID)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(it.templateID)) {
+		return common.ErrItemInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", it.templateID)))
+	}
+
+	if l := utf8.RuneCountInString(it.credentialID); l < 1 || l > types.MaxLengthCredentialID {
+		return common.ErrItemInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of credential ID <= %d", types.MaxLengthCredentialID)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(it.credentialID)) {
+		return common.ErrItemInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("credential ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", it.credentialID)))
+	}
+
+	return nil
+}
+
+func (it ReinstateItem) Contract() base.Address {
+	return it.contract
+}
+
+func (it ReinstateItem) Holder() base.Address {
+	return it.holder
+}
+
+func (it ReinstateItem) TemplateID() string {
+	return it.templateID
+}
+
+func (it ReinstateItem) CredentialID() string {
+	return it.credentialID
+}
+
+func (it ReinstateItem) Currency() crcytypes.CurrencyID {
+	return it.currency
+}
+
+func (it ReinstateItem) Addresses() []base.Address {
+	ad := make([]base.Address, 2)
+
+	ad[0] = it.contract
+	ad[1] = it.holder
+
+	return ad
+}
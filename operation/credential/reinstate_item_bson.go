@@ -0,0 +1,54 @@
+package credential // nolint:dupl
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it ReinstateItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":         it.Hint().String(),
+			"contract":      it.contract,
+			"holder":        it.holder,
+			"template_id":   it.templateID,
+			"credential_id": it.credentialID,
+			"currency":      it.currency,
+		},
+	)
+}
+
+type ReinstateItemBSONUnmarshaler struct {
+	Hint         string `bson:"_hint"`
+	Contract     string `bson:"contract"`
+	Holder       string `bson:"holder"`
+	TemplateID   string `bson:"template_id"`
+	CredentialID string `bson:"credential_id"`
+	Currency     string `bson:"currency"`
+}
+
+func (it *ReinstateItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uit ReinstateItemBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uit); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *it)
+	}
+
+	ht, err := hint.ParseHint(uit.Hint)
+	if err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *it)
+	}
+
+	if err := it.unpack(enc, ht,
+		uit.Contract,
+		uit.Holder,
+		uit.TemplateID,
+		uit.CredentialID,
+		uit.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *it)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package credential
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type ReinstateFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address    `json:"sender"`
+	Items  []ReinstateItem `json:"items"`
+}
+
+func (fact ReinstateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ReinstateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type ReinstateFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *ReinstateFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf ReinstateFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Sender, uf.Items); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type ReinstateMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Reinstate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ReinstateMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Reinstate) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	cstate "github.com/ProtoconNet/mitum-currency/v3/state"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+var reinstateItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ReinstateItemProcessor)
+	},
+}
+
+var reinstateProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ReinstateProcessor)
+	},
+}
+
+func (Reinstate) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type ReinstateItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   ReinstateItem
+}
+
+func (ipp *ReinstateItemProcessor) PreProcess(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	e := util.StringError("process ReinstateItemProcessor")
+	it := ipp.item
+
+	if err := it.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	cv, err := checkIssuedCredential(
+		ipp.sender, it.Contract(), it.Holder(), it.TemplateID(), it.CredentialID(),
+		types.RoleRevoker, it.Currency(), getStateFunc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := checkServiceOpen(it.Contract(), getStateFunc); err != nil {
+		return e.Wrap(err)
+	}
+
+	if cv.Status != types.CredentialStatusSuspended {
+		return e.Wrap(common.ErrValueInvalid.Errorf(
+			"only suspended credential can be reinstated, credential %v for template %v in contract account %v is %v",
+			it.CredentialID(), it.TemplateID(), it.Contract(), cv.Status))
+	}
+
+	return nil
+}
+
+func (ipp *ReinstateItemProcessor) Process(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	it := ipp.item
+
+	k := state.StateKeyCredential(it.Contract(), it.TemplateID(), it.CredentialID())
+
+	st, err := cstate.ExistsState(k, "credential", getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	cv, err := state.StateCredentialStateValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cv.Credential.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(
+			k,
+			cv.SetStatus(types.CredentialStatusActive),
+		),
+	}, nil
+}
+
+func (ipp *ReinstateItemProcessor) Close() {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = ReinstateItem{}
+
+	reinstateItemProcessorPool.Put(ipp)
+}
+
+type ReinstateProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewReinstateProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new ReinstateProcessor")
+
+		nopp := reinstateProcessorPool.Get()
+		opp, ok := nopp.(*ReinstateProcessor)
+		if !ok {
+			return nil, e.Errorf("expected ReinstateProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ReinstateProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(ReinstateFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ReinstateFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if _, _, aErr, cErr := cstate.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: sender is contract account, %q", fact.Sender(), cErr)), nil
+	}
+
+	if err := cstate.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	for _, it := range fact.Items() {
+		ip := reinstateItemProcessorPool.Get()
+		ipc, ok := ip.(*ReinstateItemProcessor)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMTypeMismatch.Errorf("expected ReinstateItemProcessor, not %T", ip)), nil
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Errorf("%v", err),
+			), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ReinstateProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process Reinstate")
+
+	fact, ok := op.Fact().(ReinstateFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected ReinstateFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for _, it := range fact.Items() {
+		ip := reinstateItemProcessorPool.Get()
+		ipc, ok := ip.(*ReinstateItemProcessor)
+		if !ok {
+			return nil, nil, e.Errorf("expected ReinstateItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+
+		st, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process ReinstateItem; %w", err), nil
+		}
+
+		sts = append(sts, st...)
+		ipc.Close()
+	}
+
+	items := make([]CredentialItem, len(fact.Items()))
+	for i := range fact.Items() {
+		items[i] = fact.Items()[i]
+	}
+
+	feeSts, rErr, err := processCredentialItemsFee(getStateFunc, fact.Sender(), items)
+	if rErr != nil || err != nil {
+		return nil, rErr, err
+	}
+	sts = append(sts, feeSts...)
+
+	return sts, nil, nil
+}
+
+func (opp *ReinstateProcessor) Close() error {
+	reinstateProcessorPool.Put(opp)
+
+	return nil
+}
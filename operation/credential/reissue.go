@@ -0,0 +1,126 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ReissueFactHint = hint.MustNewHint("mitum-credential-reissue-operation-fact-v0.0.1")
+	ReissueHint     = hint.MustNewHint("mitum-credential-reissue-operation-v0.0.1")
+)
+
+// ReissueFact revokes the credential of credentialID as superseded and issues
+// the credential of item which supersedes it. The credential of item should be
+// of the same template and holder with the superseded credential.
+type ReissueFact struct {
+	base.BaseFact
+	sender       base.Address
+	credentialID string
+	item         IssueItem
+}
+
+func NewReissueFact(
+	token []byte, sender base.Address, credentialID string, item IssueItem,
+) ReissueFact {
+	bf := base.NewBaseFact(ReissueFactHint, token)
+	fact := ReissueFact{
+		BaseFact:     bf,
+		sender:       sender,
+		credentialID: credentialID,
+		item:         item,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ReissueFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ReissueFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ReissueFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		[]byte(fact.credentialID),
+		fact.item.Bytes(),
+	)
+}
+
+func (fact ReissueFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.item,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(fact.credentialID); l < 1 || l > types.MaxLengthCredentialID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of credential ID <= %d, but %d", types.MaxLengthCredentialID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.credentialID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("credential ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.credentialID)))
+	}
+
+	if fact.credentialID == fact.item.CredentialID() {
+		return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("credential ID %s is same with superseded credential", fact.credentialID)))
+	}
+
+	if fact.item.Contract().Equal(fact.sender) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if fact.item.Holder().Equal(fact.sender) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with holder", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ReissueFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ReissueFact) Sender() base.Address {
+	return fact.sender
+}
+
+// CredentialID returns the ID of the superseded credential.
+func (fact ReissueFact) CredentialID() string {
+	return fact.credentialID
+}
+
+func (fact ReissueFact) Item() IssueItem {
+	return fact.item
+}
+
+func (fact ReissueFact) Addresses() ([]base.Address, error) {
+	return append(fact.item.Addresses(), fact.sender), nil
+}
+
+type Reissue struct {
+	common.BaseOperation
+}
+
+func NewReissue(fact ReissueFact) Reissue {
+	return Reissue{BaseOperation: common.NewBaseOperation(ReissueHint, fact)}
+}
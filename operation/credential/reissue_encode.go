@@ -0,0 +1,32 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *ReissueFact) unpack(enc encoder.Encoder, sa, crdID string, bit []byte) error {
+	fact.credentialID = crdID
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	hit, err := enc.Decode(bit)
+	if err != nil {
+		return err
+	}
+
+	item, ok := hit.(IssueItem)
+	if !ok {
+		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected %T, not %T", IssueItem{}, hit))
+	}
+	fact.item = item
+
+	return nil
+}
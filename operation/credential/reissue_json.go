@@ -0,0 +1,70 @@
+package credential
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type ReissueFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender       base.Address `json:"sender"`
+	CredentialID string       `json:"credential_id"`
+	Item         IssueItem    `json:"item"`
+}
+
+func (fact ReissueFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ReissueFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		CredentialID:          fact.credentialID,
+		Item:                  fact.item,
+	})
+}
+
+type ReissueFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender       string          `json:"sender"`
+	CredentialID string          `json:"credential_id"`
+	Item         json.RawMessage `json:"item"`
+}
+
+func (fact *ReissueFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf ReissueFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Sender, uf.CredentialID, uf.Item); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type ReissueMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Reissue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ReissueMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Reissue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
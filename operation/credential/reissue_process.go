@@ -0,0 +1,204 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var reissueProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ReissueProcessor)
+	},
+}
+
+func (Reissue) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type ReissueProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewReissueProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new ReissueProcessor")
+
+		nopp := reissueProcessorPool.Get()
+		opp, ok := nopp.(*ReissueProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ReissueProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ReissueProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(ReissueFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ReissueFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if _, _, aErr, cErr := currencystate.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: sender %v is contract account", cErr, fact.Sender())), nil
+	}
+
+	if _, err := checkSupersedable(fact.Sender(), fact.CredentialID(), fact.Item(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	// NOTE the holder already has the superseded credential, so the credential
+	// of template requiring consent of holder is reissued without offer.
+	template, err := checkIssuable(fact.Sender(), fact.Item(), opp.Height(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkIssuanceLimits(
+		fact.Item().Contract(), fact.Item().Holder(), template, fact.Item().Value(), fact.CredentialID(),
+		nil, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkServiceOpen(fact.Item().Contract(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ReissueProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process Reissue")
+
+	fact, ok := op.Fact().(ReissueFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected ReissueFact, not %T", op.Fact())
+	}
+
+	it := fact.Item()
+
+	cv, err := checkSupersedable(fact.Sender(), fact.CredentialID(), it, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	stats, err := newHolderStats(it.Contract(), opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"credential design value not found, %s; %w", it.Contract(), err), nil
+	}
+
+	quotas := newIssuanceQuotas(opp.Height())
+	if err := quotas.add(it.Contract(), it.TemplateOwner(), it.TemplateID(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := stats.remove(it.Holder(), types.NewCredentialRef(it.TemplateID(), fact.CredentialID()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	ip := issueItemProcessorPool.Get()
+	ipc, ok := ip.(*IssueItemProcessor)
+	if !ok {
+		return nil, nil, e.Errorf("expected IssueItemProcessor, not %T", ip)
+	}
+
+	ipc.h = op.Hash()
+	ipc.sender = fact.Sender()
+	ipc.item = it
+	ipc.stats = stats
+	ipc.supersedes = fact.CredentialID()
+
+	sts, err := ipc.Process(ctx, op, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to process IssueItem; %w", err), nil
+	}
+
+	ipc.Close()
+
+	sts = append(sts, currencystate.NewStateMergeValue(
+		state.StateKeyCredential(it.Contract(), it.TemplateID(), fact.CredentialID()),
+		cv.SetStatus(types.CredentialStatusRevoked).SetRevocation(
+			types.NewRevocation(types.RevocationReasonSuperseded, "", fact.Sender(), opp.Height()),
+		).SetSupersededBy(it.CredentialID()),
+	))
+
+	statSts, err := stats.states()
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+	sts = append(sts, statSts...)
+	sts = append(sts, quotas.states()...)
+
+	feeSts, rErr, err := processCredentialItemsFee(getStateFunc, fact.Sender(), []CredentialItem{it})
+	if rErr != nil || err != nil {
+		return nil, rErr, err
+	}
+
+	return append(sts, feeSts...), nil, nil
+}
+
+func (opp *ReissueProcessor) Close() error {
+	reissueProcessorPool.Put(opp)
+
+	return nil
+}
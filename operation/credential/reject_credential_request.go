@@ -0,0 +1,159 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RejectCredentialRequestFactHint = hint.MustNewHint("mitum-credential-reject-credential-request-operation-fact-v0.0.1")
+	RejectCredentialRequestHint     = hint.MustNewHint("mitum-credential-reject-credential-request-operation-v0.0.1")
+)
+
+// RejectCredentialRequestFact rejects the pending credential request of
+// requestID with reason.
+type RejectCredentialRequestFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	requestID  string
+	reason     string
+	currency   crcytypes.CurrencyID
+}
+
+func NewRejectCredentialRequestFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	requestID string,
+	reason string,
+	currency crcytypes.CurrencyID,
+) RejectCredentialRequestFact {
+	bf := base.NewBaseFact(RejectCredentialRequestFactHint, token)
+	fact := RejectCredentialRequestFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		requestID:  requestID,
+		reason:     reason,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RejectCredentialRequestFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RejectCredentialRequestFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RejectCredentialRequestFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		[]byte(fact.requestID),
+		[]byte(fact.reason),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RejectCredentialRequestFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > types.MaxLengthTemplateID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d, but %d", types.MaxLengthTemplateID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.templateID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.TemplateID())))
+	}
+
+	if l := utf8.RuneCountInString(fact.requestID); l < 1 || l > types.MaxLengthRequestID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of request ID <= %d, but %d", types.MaxLengthRequestID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.requestID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("request ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.RequestID())))
+	}
+
+	if l := utf8.RuneCountInString(fact.reason); l < 1 || l > types.MaxLengthRejectReason {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 < length of reject reason <= %d, but %d", types.MaxLengthRejectReason, l)))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RejectCredentialRequestFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RejectCredentialRequestFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RejectCredentialRequestFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RejectCredentialRequestFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact RejectCredentialRequestFact) RequestID() string {
+	return fact.requestID
+}
+
+func (fact RejectCredentialRequestFact) Reason() string {
+	return fact.reason
+}
+
+func (fact RejectCredentialRequestFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RejectCredentialRequestFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type RejectCredentialRequest struct {
+	common.BaseOperation
+}
+
+func NewRejectCredentialRequest(fact RejectCredentialRequestFact) RejectCredentialRequest {
+	return RejectCredentialRequest{BaseOperation: common.NewBaseOperation(RejectCredentialRequestHint, fact)}
+}
@@ -0,0 +1,84 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type RejectCredentialRequestFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	RequestID  string                   `json:"request_id"`
+	Reason     string                   `json:"reason"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RejectCredentialRequestFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RejectCredentialRequestFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		RequestID:             fact.requestID,
+		Reason:                fact.reason,
+		Currency:              fact.currency,
+	})
+}
+
+type RejectCredentialRequestFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string `json:"sender"`
+	Contract   string `json:"contract"`
+	TemplateID string `json:"template_id"`
+	RequestID  string `json:"request_id"`
+	Reason     string `json:"reason"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *RejectCredentialRequestFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf RejectCredentialRequestFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.TemplateID,
+		uf.RequestID,
+		uf.Reason,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type RejectCredentialRequestMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RejectCredentialRequest) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RejectCredentialRequestMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RejectCredentialRequest) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
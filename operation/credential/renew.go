@@ -0,0 +1,136 @@
+package credential
+
+import (
+	"fmt"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RenewFactHint = hint.MustNewHint("mitum-credential-renew-operation-fact-v0.0.1")
+	RenewHint     = hint.MustNewHint("mitum-credential-renew-operation-v0.0.1")
+)
+
+var MaxRenewItems uint = 1000
+
+type RenewFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []RenewItem
+}
+
+func NewRenewFact(token []byte, sender base.Address, items []RenewItem) RenewFact {
+	bf := base.NewBaseFact(RenewFactHint, token)
+	fact := RenewFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RenewFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RenewFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RenewFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact RenewFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("empty items")))
+	} else if n > int(MaxRenewItems) {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("items, %d over max, %d", n, MaxRenewItems)))
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, it := range fact.items {
+		if err := it.IsValid(nil); err != nil {
+			return err
+		}
+
+		if it.contract.Equal(fact.sender) {
+			return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+		}
+
+		k := fmt.Sprintf("%s-%s-%s", it.contract, it.templateID, it.credentialID)
+
+		if _, found := founds[k]; found {
+			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("credential id %v for template %v in contract account %v", it.CredentialID(), it.TemplateID(), it.Contract())))
+		}
+
+		founds[k] = struct{}{}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact RenewFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RenewFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RenewFact) Items() []RenewItem {
+	return fact.items
+}
+
+func (fact RenewFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{}
+
+	adrMap := make(map[string]struct{})
+	for i := range fact.items {
+		for j := range fact.items[i].Addresses() {
+			if _, found := adrMap[fact.items[i].Addresses()[j].String()]; !found {
+				adrMap[fact.items[i].Addresses()[j].String()] = struct{}{}
+				as = append(as, fact.items[i].Addresses()[j])
+			}
+		}
+	}
+	as = append(as, fact.sender)
+
+	return as, nil
+}
+
+type Renew struct {
+	common.BaseOperation
+}
+
+func NewRenew(fact RenewFact) Renew {
+	return Renew{BaseOperation: common.NewBaseOperation(RenewHint, fact)}
+}
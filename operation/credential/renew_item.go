@@ -0,0 +1,138 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var RenewItemHint = hint.MustNewHint("mitum-credential-renew-item-v0.0.1")
+
+// RenewItem extends the validity period of the active credential in place;
+// zero validFrom keeps the current validFrom of the credential.
+type RenewItem struct {
+	hint.BaseHinter
+	contract     base.Address
+	holder       base.Address
+	templateID   string
+	credentialID string
+	validFrom    uint64
+	validUntil   uint64
+	currency     crcytypes.CurrencyID
+}
+
+func NewRenewItem(
+	contract base.Address,
+	holder base.Address,
+	templateID, credentialID string,
+	validFrom, validUntil uint64,
+	currency crcytypes.CurrencyID,
+) RenewItem {
+	return RenewItem{
+		BaseHinter:   hint.NewBaseHinter(RenewItemHint),
+		contract:     contract,
+		holder:       holder,
+		templateID:   templateID,
+		credentialID: credentialID,
+		validFrom:    validFrom,
+		validUntil:   validUntil,
+		currency:     currency,
+	}
+}
+
+func (it RenewItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.contract.Bytes(),
+		it.holder.Bytes(),
+		[]byte(it.templateID),
+		[]byte(it.credentialID),
+		util.Uint64ToBytes(it.validFrom),
+		util.Uint64ToBytes(it.validUntil),
+		it.currency.Bytes(),
+	)
+}
+
+func (it RenewItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.contract,
+		it.holder,
+		it.currency,
+	); err != nil {
+		return err
+	}
+
+	if it.contract.Equal(it.holder) {
+		return common.ErrItemInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("contract address is same with holder, %q", it.holder)))
+	}
+
+	if l := utf8.RuneCountInString(it.templateID); l < 1 || l > types.MaxLengthTemplateID {
+		return common.ErrItemInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d", types.MaxLengthTemplateID)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(it.templateID)) {
+		return common.ErrItemInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", it.templateID)))
+	}
+
+	if l := utf8.RuneCountInString(it.credentialID); l < 1 || l > types.MaxLengthCredentialID {
+		return common.ErrItemInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of credential ID <= %d", types.MaxLengthCredentialID)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(it.credentialID)) {
+		return common.ErrItemInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("credential ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", it.credentialID)))
+	}
+
+	if it.validUntil == 0 {
+		return common.ErrItemInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("empty valid until")))
+	}
+
+	if it.validFrom != 0 && it.validUntil <= it.validFrom {
+		return common.ErrItemInvalid.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("valid until <= valid from, %d <= %d", it.validUntil, it.validFrom)))
+	}
+
+	return nil
+}
+
+func (it RenewItem) Contract() base.Address {
+	return it.contract
+}
+
+func (it RenewItem) Holder() base.Address {
+	return it.holder
+}
+
+func (it RenewItem) TemplateID() string {
+	return it.templateID
+}
+
+func (it RenewItem) CredentialID() string {
+	return it.credentialID
+}
+
+func (it RenewItem) ValidFrom() uint64 {
+	return it.validFrom
+}
+
+func (it RenewItem) ValidUntil() uint64 {
+	return it.validUntil
+}
+
+func (it RenewItem) Currency() crcytypes.CurrencyID {
+	return it.currency
+}
+
+func (it RenewItem) Addresses() []base.Address {
+	ad := make([]base.Address, 2)
+
+	ad[0] = it.contract
+	ad[1] = it.holder
+
+	return ad
+}
@@ -0,0 +1,67 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type RenewItemJSONMarshaler struct {
+	hint.BaseHinter
+	Contract     base.Address     `json:"contract"`
+	Holder       base.Address     `json:"holder"`
+	TemplateID   string           `json:"template_id"`
+	CredentialID string           `json:"credential_id"`
+	ValidFrom    uint64           `json:"valid_from"`
+	ValidUntil   uint64           `json:"valid_until"`
+	Currency     types.CurrencyID `json:"currency"`
+}
+
+func (it RenewItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RenewItemJSONMarshaler{
+		BaseHinter:   it.BaseHinter,
+		Contract:     it.contract,
+		Holder:       it.holder,
+		TemplateID:   it.templateID,
+		CredentialID: it.credentialID,
+		ValidFrom:    it.validFrom,
+		ValidUntil:   it.validUntil,
+		Currency:     it.currency,
+	})
+}
+
+type RenewItemJSONUnmarshaler struct {
+	Hint         hint.Hint `json:"_hint"`
+	Contract     string    `json:"contract"`
+	Holder       string    `json:"holder"`
+	TemplateID   string    `json:"template_id"`
+	CredentialID string    `json:"credential_id"`
+	ValidFrom    uint64    `json:"valid_from"`
+	ValidUntil   uint64    `json:"valid_until"`
+	Currency     string    `json:"currency"`
+}
+
+func (it *RenewItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uit RenewItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *it)
+	}
+
+	if err := it.unpack(enc,
+		uit.Hint,
+		uit.Contract,
+		uit.Holder,
+		uit.TemplateID,
+		uit.CredentialID,
+		uit.ValidFrom,
+		uit.ValidUntil,
+		uit.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *it)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package credential
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type RenewFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address `json:"sender"`
+	Items  []RenewItem  `json:"items"`
+}
+
+func (fact RenewFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RenewFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type RenewFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *RenewFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf RenewFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Sender, uf.Items); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type RenewMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Renew) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RenewMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Renew) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,294 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	cstate "github.com/ProtoconNet/mitum-currency/v3/state"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+var renewItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RenewItemProcessor)
+	},
+}
+
+var renewProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RenewProcessor)
+	},
+}
+
+func (Renew) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RenewItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   RenewItem
+	height base.Height
+}
+
+func (ipp *RenewItemProcessor) PreProcess(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	e := util.StringError("process RenewItemProcessor")
+	it := ipp.item
+
+	if err := it.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	cv, err := checkIssuedCredential(
+		ipp.sender, it.Contract(), it.Holder(), it.TemplateID(), it.CredentialID(),
+		types.RoleIssuer, it.Currency(), getStateFunc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := checkServiceOpen(it.Contract(), getStateFunc); err != nil {
+		return e.Wrap(err)
+	}
+
+	if cv.Status != types.CredentialStatusActive {
+		return e.Wrap(common.ErrValueInvalid.Errorf(
+			"only active credential can be renewed, credential %v for template %v in contract account %v is %v",
+			it.CredentialID(), it.TemplateID(), it.Contract(), cv.Status))
+	}
+
+	if it.ValidUntil() <= cv.Credential.ValidUntil() {
+		return e.Wrap(common.ErrValueInvalid.Errorf(
+			"valid until, %d not extended from %d of credential %v for template %v in contract account %v",
+			it.ValidUntil(), cv.Credential.ValidUntil(), it.CredentialID(), it.TemplateID(), it.Contract()))
+	}
+
+	credential := renewedCredential(cv.Credential, it)
+	if err := credential.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	template, err := credentialTemplate(it.Contract(), cv.Credential, getStateFunc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := template.CheckServiceHeight(ipp.height); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := template.CheckServicePeriod(credential.ValidFrom(), credential.ValidUntil()); err != nil {
+		return e.Wrap(err)
+	}
+
+	// NOTE the renewed validity period is checked with the same unix seconds
+	// as the issuance, so the credential does not outlive its prerequisites.
+	if err := checkPrerequisites(
+		it.Contract(), credential.Holder(), template, credential.ValidFrom(), credential.ValidUntil(),
+		getStateFunc); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ipp *RenewItemProcessor) Process(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	it := ipp.item
+
+	k := state.StateKeyCredential(it.Contract(), it.TemplateID(), it.CredentialID())
+
+	st, err := cstate.ExistsState(k, "credential", getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	cv, err := state.StateCredentialStateValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := renewedCredential(cv.Credential, it)
+	if err := credential.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	return []base.StateMergeValue{
+		cstate.NewStateMergeValue(
+			k,
+			cv.SetCredential(credential).SetRenewal(cv.Revision+1, ipp.height),
+		),
+	}, nil
+}
+
+// renewedCredential returns the credential with the validity period of it.
+func renewedCredential(credential types.Credential, it RenewItem) types.Credential {
+	validFrom := credential.ValidFrom()
+	if it.ValidFrom() != 0 {
+		validFrom = it.ValidFrom()
+	}
+
+	return credential.SetValidity(validFrom, it.ValidUntil())
+}
+
+func (ipp *RenewItemProcessor) Close() {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = RenewItem{}
+	ipp.height = 0
+
+	renewItemProcessorPool.Put(ipp)
+}
+
+type RenewProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRenewProcessor() ctypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RenewProcessor")
+
+		nopp := renewProcessorPool.Get()
+		opp, ok := nopp.(*RenewProcessor)
+		if !ok {
+			return nil, e.Errorf("expected RenewProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RenewProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(RenewFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", RenewFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if _, _, aErr, cErr := cstate.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: sender is contract account, %q", fact.Sender(), cErr)), nil
+	}
+
+	if err := cstate.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	for _, it := range fact.Items() {
+		ip := renewItemProcessorPool.Get()
+		ipc, ok := ip.(*RenewItemProcessor)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMTypeMismatch.Errorf("expected RenewItemProcessor, not %T", ip)), nil
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+		ipc.height = opp.Height()
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Errorf("%v", err),
+			), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RenewProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process Renew")
+
+	fact, ok := op.Fact().(RenewFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected RenewFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for _, it := range fact.Items() {
+		ip := renewItemProcessorPool.Get()
+		ipc, ok := ip.(*RenewItemProcessor)
+		if !ok {
+			return nil, nil, e.Errorf("expected RenewItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+		ipc.height = opp.Height()
+
+		st, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process RenewItem; %w", err), nil
+		}
+
+		sts = append(sts, st...)
+		ipc.Close()
+	}
+
+	items := make([]CredentialItem, len(fact.Items()))
+	for i := range fact.Items() {
+		items[i] = fact.Items()[i]
+	}
+
+	feeSts, rErr, err := processCredentialItemsFee(getStateFunc, fact.Sender(), items)
+	if rErr != nil || err != nil {
+		return nil, rErr, err
+	}
+	sts = append(sts, feeSts...)
+
+	return sts, nil, nil
+}
+
+func (opp *RenewProcessor) Close() error {
+	renewProcessorPool.Put(opp)
+
+	return nil
+}
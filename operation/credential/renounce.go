@@ -0,0 +1,148 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RenounceFactHint = hint.MustNewHint("mitum-credential-renounce-operation-fact-v0.0.1")
+	RenounceHint     = hint.MustNewHint("mitum-credential-renounce-operation-v0.0.1")
+)
+
+// RenounceFact renounces the credential of credentialID by its holder, the
+// sender. The renounced credential is no longer valid like the revoked one,
+// but it is kept apart from the revocation by the issuer.
+type RenounceFact struct {
+	base.BaseFact
+	sender       base.Address
+	contract     base.Address
+	templateID   string
+	credentialID string
+	currency     crcytypes.CurrencyID
+}
+
+func NewRenounceFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	credentialID string,
+	currency crcytypes.CurrencyID,
+) RenounceFact {
+	bf := base.NewBaseFact(RenounceFactHint, token)
+	fact := RenounceFact{
+		BaseFact:     bf,
+		sender:       sender,
+		contract:     contract,
+		templateID:   templateID,
+		credentialID: credentialID,
+		currency:     currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RenounceFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RenounceFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RenounceFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		[]byte(fact.credentialID),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RenounceFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > types.MaxLengthTemplateID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d, but %d", types.MaxLengthTemplateID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.templateID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.TemplateID())))
+	}
+
+	if l := utf8.RuneCountInString(fact.credentialID); l < 1 || l > types.MaxLengthCredentialID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of credential ID <= %d, but %d", types.MaxLengthCredentialID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.credentialID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("credential ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.CredentialID())))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RenounceFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RenounceFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RenounceFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RenounceFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact RenounceFact) CredentialID() string {
+	return fact.credentialID
+}
+
+func (fact RenounceFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RenounceFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type Renounce struct {
+	common.BaseOperation
+}
+
+func NewRenounce(fact RenounceFact) Renounce {
+	return Renounce{BaseOperation: common.NewBaseOperation(RenounceHint, fact)}
+}
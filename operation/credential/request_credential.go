@@ -0,0 +1,160 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RequestCredentialFactHint = hint.MustNewHint("mitum-credential-request-credential-operation-fact-v0.0.1")
+	RequestCredentialHint     = hint.MustNewHint("mitum-credential-request-credential-operation-v0.0.1")
+)
+
+// RequestCredentialFact requests the credential of value under the template to
+// the issuers of the template. The holder is the sender; the credential is
+// issued when an issuer approves the request by ApproveCredentialRequest.
+type RequestCredentialFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	requestID  string
+	value      string
+	currency   crcytypes.CurrencyID
+}
+
+func NewRequestCredentialFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	requestID string,
+	value string,
+	currency crcytypes.CurrencyID,
+) RequestCredentialFact {
+	bf := base.NewBaseFact(RequestCredentialFactHint, token)
+	fact := RequestCredentialFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		requestID:  requestID,
+		value:      value,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RequestCredentialFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RequestCredentialFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RequestCredentialFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		[]byte(fact.requestID),
+		[]byte(fact.value),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RequestCredentialFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > types.MaxLengthTemplateID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d, but %d", types.MaxLengthTemplateID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.templateID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.TemplateID())))
+	}
+
+	if l := utf8.RuneCountInString(fact.requestID); l < 1 || l > types.MaxLengthRequestID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of request ID <= %d, but %d", types.MaxLengthRequestID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.requestID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("request ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.RequestID())))
+	}
+
+	if l := utf8.RuneCountInString(fact.value); l < 1 || l > types.MaxLengthCredentialValue {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of credential value <= %d, but %d", types.MaxLengthCredentialValue, l)))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RequestCredentialFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RequestCredentialFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RequestCredentialFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RequestCredentialFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact RequestCredentialFact) RequestID() string {
+	return fact.requestID
+}
+
+func (fact RequestCredentialFact) Value() string {
+	return fact.value
+}
+
+func (fact RequestCredentialFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RequestCredentialFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type RequestCredential struct {
+	common.BaseOperation
+}
+
+func NewRequestCredential(fact RequestCredentialFact) RequestCredential {
+	return RequestCredential{BaseOperation: common.NewBaseOperation(RequestCredentialHint, fact)}
+}
@@ -0,0 +1,84 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type RequestCredentialFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	RequestID  string                   `json:"request_id"`
+	Value      string                   `json:"value"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RequestCredentialFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RequestCredentialFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		RequestID:             fact.requestID,
+		Value:                 fact.value,
+		Currency:              fact.currency,
+	})
+}
+
+type RequestCredentialFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string `json:"sender"`
+	Contract   string `json:"contract"`
+	TemplateID string `json:"template_id"`
+	RequestID  string `json:"request_id"`
+	Value      string `json:"value"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *RequestCredentialFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf RequestCredentialFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.TemplateID,
+		uf.RequestID,
+		uf.Value,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type RequestCredentialMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RequestCredential) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RequestCredentialMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RequestCredential) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ResumeServiceFactHint = hint.MustNewHint("mitum-credential-resume-service-operation-fact-v0.0.1")
+	ResumeServiceHint     = hint.MustNewHint("mitum-credential-resume-service-operation-v0.0.1")
+)
+
+type ResumeServiceFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	currency crcytypes.CurrencyID
+}
+
+func NewResumeServiceFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	currency crcytypes.CurrencyID,
+) ResumeServiceFact {
+	bf := base.NewBaseFact(ResumeServiceFactHint, token)
+	fact := ResumeServiceFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ResumeServiceFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ResumeServiceFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ResumeServiceFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ResumeServiceFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ResumeServiceFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ResumeServiceFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ResumeServiceFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact ResumeServiceFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact ResumeServiceFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type ResumeService struct {
+	common.BaseOperation
+}
+
+func NewResumeService(fact ResumeServiceFact) ResumeService {
+	return ResumeService{BaseOperation: common.NewBaseOperation(ResumeServiceHint, fact)}
+}
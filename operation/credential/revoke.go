@@ -18,6 +18,8 @@ var (
 
 var MaxRevokeItems uint = 1000
 
+var MaxRevokeAllCredentials uint = 1000
+
 type RevokeFact struct {
 	base.BaseFact
 	sender base.Address
@@ -82,13 +84,13 @@ func (fact RevokeFact) IsValid(b []byte) error {
 			return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
 		}
 
-		k := fmt.Sprintf("%s-%s", it.contract, it.credentialID)
+		k := fmt.Sprintf("%s-%s-%s", it.contract, it.templateID, it.credentialID)
 
 		if _, found := founds[k]; found {
 			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("credential id %v for template %v in contract account %v", it.CredentialID(), it.TemplateID(), it.Contract())))
 		}
 
-		founds[it.CredentialID()] = struct{}{}
+		founds[k] = struct{}{}
 	}
 
 	if err := common.IsValidOperationFact(fact, b); err != nil {
@@ -0,0 +1,151 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RevokeAllByHolderFactHint = hint.MustNewHint("mitum-credential-revoke-all-by-holder-operation-fact-v0.0.1")
+	RevokeAllByHolderHint     = hint.MustNewHint("mitum-credential-revoke-all-by-holder-operation-v0.0.1")
+)
+
+// RevokeAllByHolderFact revokes the credentials of holder in the credential
+// service, for example on the termination of the holder account. An operation
+// revokes at most MaxRevokeAllCredentials credentials of holder, so the
+// following operations revoke the rest. The operation is rejected if the
+// credentials of holder issued by the previous versions are not indexed.
+type RevokeAllByHolderFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	holder   base.Address
+	reason   types.RevocationReason
+	note     string
+	currency crcytypes.CurrencyID
+}
+
+func NewRevokeAllByHolderFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	holder base.Address,
+	reason types.RevocationReason,
+	note string,
+	currency crcytypes.CurrencyID,
+) RevokeAllByHolderFact {
+	bf := base.NewBaseFact(RevokeAllByHolderFactHint, token)
+	fact := RevokeAllByHolderFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		holder:   holder,
+		reason:   reason,
+		note:     note,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RevokeAllByHolderFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RevokeAllByHolderFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RevokeAllByHolderFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.holder.Bytes(),
+		fact.reason.Bytes(),
+		[]byte(fact.note),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RevokeAllByHolderFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.holder,
+		fact.reason,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := types.IsValidRevocationNote(fact.note); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.contract.Equal(fact.holder) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("contract address is same with holder, %q", fact.holder)))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RevokeAllByHolderFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RevokeAllByHolderFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RevokeAllByHolderFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RevokeAllByHolderFact) Holder() base.Address {
+	return fact.holder
+}
+
+func (fact RevokeAllByHolderFact) Reason() types.RevocationReason {
+	return fact.reason
+}
+
+func (fact RevokeAllByHolderFact) Note() string {
+	return fact.note
+}
+
+func (fact RevokeAllByHolderFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RevokeAllByHolderFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 3)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	as[2] = fact.holder
+	return as, nil
+}
+
+type RevokeAllByHolder struct {
+	common.BaseOperation
+}
+
+func NewRevokeAllByHolder(fact RevokeAllByHolderFact) RevokeAllByHolder {
+	return RevokeAllByHolder{BaseOperation: common.NewBaseOperation(RevokeAllByHolderHint, fact)}
+}
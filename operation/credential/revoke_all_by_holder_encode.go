@@ -0,0 +1,39 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *RevokeAllByHolderFact) unpack(enc encoder.Encoder,
+	sAdr, cAdr, hAdr, reason, note, cid string,
+) error {
+	fact.reason = types.RevocationReason(reason)
+	fact.note = note
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(hAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.holder = a
+	}
+
+	return nil
+}
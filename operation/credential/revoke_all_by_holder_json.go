@@ -0,0 +1,85 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type RevokeAllByHolderFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner    base.Address             `json:"sender"`
+	Contract base.Address             `json:"contract"`
+	Holder   base.Address             `json:"holder"`
+	Reason   types.RevocationReason   `json:"reason"`
+	Note     string                   `json:"note"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RevokeAllByHolderFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeAllByHolderFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		Holder:                fact.holder,
+		Reason:                fact.reason,
+		Note:                  fact.note,
+		Currency:              fact.currency,
+	})
+}
+
+type RevokeAllByHolderFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner    string `json:"sender"`
+	Contract string `json:"contract"`
+	Holder   string `json:"holder"`
+	Reason   string `json:"reason"`
+	Note     string `json:"note"`
+	Currency string `json:"currency"`
+}
+
+func (fact *RevokeAllByHolderFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf RevokeAllByHolderFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.Holder,
+		uf.Reason,
+		uf.Note,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type RevokeAllByHolderMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RevokeAllByHolder) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeAllByHolderMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RevokeAllByHolder) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RevokeAllByTemplateFactHint = hint.MustNewHint("mitum-credential-revoke-all-by-template-operation-fact-v0.0.1")
+	RevokeAllByTemplateHint     = hint.MustNewHint("mitum-credential-revoke-all-by-template-operation-v0.0.1")
+)
+
+// RevokeAllByTemplateFact revokes the credentials of the template of
+// templateID in the credential service. An operation examines at most
+// MaxRevokeAllCredentials credentials from the revocation cursor of the
+// template, so the following operations continue the revocation until the
+// credential pages of the template are all walked. The credentials issued
+// after the revocation started, or issued by the previous versions and
+// indexed by IndexCredentials later, are revoked by the next revocation,
+// which starts over from the last page.
+type RevokeAllByTemplateFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	reason     types.RevocationReason
+	note       string
+	currency   crcytypes.CurrencyID
+}
+
+func NewRevokeAllByTemplateFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	reason types.RevocationReason,
+	note string,
+	currency crcytypes.CurrencyID,
+) RevokeAllByTemplateFact {
+	bf := base.NewBaseFact(RevokeAllByTemplateFactHint, token)
+	fact := RevokeAllByTemplateFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		reason:     reason,
+		note:       note,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RevokeAllByTemplateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RevokeAllByTemplateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RevokeAllByTemplateFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		fact.reason.Bytes(),
+		[]byte(fact.note),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RevokeAllByTemplateFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.reason,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > types.MaxLengthTemplateID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d, but %d", types.MaxLengthTemplateID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.templateID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.TemplateID())))
+	}
+
+	if err := types.IsValidRevocationNote(fact.note); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RevokeAllByTemplateFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RevokeAllByTemplateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RevokeAllByTemplateFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RevokeAllByTemplateFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact RevokeAllByTemplateFact) Reason() types.RevocationReason {
+	return fact.reason
+}
+
+func (fact RevokeAllByTemplateFact) Note() string {
+	return fact.note
+}
+
+func (fact RevokeAllByTemplateFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RevokeAllByTemplateFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type RevokeAllByTemplate struct {
+	common.BaseOperation
+}
+
+func NewRevokeAllByTemplate(fact RevokeAllByTemplateFact) RevokeAllByTemplate {
+	return RevokeAllByTemplate{BaseOperation: common.NewBaseOperation(RevokeAllByTemplateHint, fact)}
+}
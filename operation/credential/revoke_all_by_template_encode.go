@@ -0,0 +1,33 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *RevokeAllByTemplateFact) unpack(enc encoder.Encoder,
+	sAdr, cAdr, tmplID, reason, note, cid string,
+) error {
+	fact.templateID = tmplID
+	fact.reason = types.RevocationReason(reason)
+	fact.note = note
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type RevokeAllByTemplateFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	Reason     types.RevocationReason   `json:"reason"`
+	Note       string                   `json:"note"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RevokeAllByTemplateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeAllByTemplateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		Reason:                fact.reason,
+		Note:                  fact.note,
+		Currency:              fact.currency,
+	})
+}
+
+type RevokeAllByTemplateFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string `json:"sender"`
+	Contract   string `json:"contract"`
+	TemplateID string `json:"template_id"`
+	Reason     string `json:"reason"`
+	Note       string `json:"note"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *RevokeAllByTemplateFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf RevokeAllByTemplateFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.TemplateID,
+		uf.Reason,
+		uf.Note,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type RevokeAllByTemplateMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RevokeAllByTemplate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeAllByTemplateMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RevokeAllByTemplate) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package credential
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type revokeAllTest struct {
+	*test.TestProcessor
+	sender   []test.Account
+	contract []test.Account
+	holders  []test.Account
+}
+
+func newRevokeAllTest(t *testing.T, credentials int) *revokeAllTest {
+	tp := &test.TestProcessor{}
+	tp.Setup(test.NewMockStateGetter())
+
+	r := &revokeAllTest{
+		TestProcessor: tp,
+		sender:        make([]test.Account, 1),
+		contract:      make([]test.Account, 1),
+		holders:       make([]test.Account, 2),
+	}
+
+	p := NewTestCloseServiceProcessor(tp)
+	p.SetAccount(tp.NewPrivateKey("sender"), 1000000000, tp.GenesisCurrency, r.sender, true).
+		SetAccount(tp.NewPrivateKey("holder0"), 1000, tp.GenesisCurrency, r.holders[:1], true).
+		SetAccount(tp.NewPrivateKey("holder1"), 1000, tp.GenesisCurrency, r.holders[1:], true).
+		SetContractAccount(r.sender[0].Address(), tp.NewPrivateKey("contract"), 1000, tp.GenesisCurrency, r.contract, true)
+
+	p.SetService(r.contract[0].Address(), types.NewTemplate(
+		"t1", "template", "2024-01-01", "2099-12-31", false, false,
+		"template", "subject", "template", r.sender[0].Address(), "", "", nil, 0,
+	))
+
+	// NOTE the credentials are issued in the separate credential pages.
+	for i := 0; i < credentials; i++ {
+		items := make([]IssueItem, 1)
+
+		ip := NewTestIssueProcessor(tp)
+		ip.SetTemplate("t1", fmt.Sprintf("credential%d", i), "value", 1735689600, 1767225600, "did").
+			MakeItem(r.contract[0], r.holders[i%2], tp.GenesisCurrency, items)
+
+		ip.Opr, _ = NewIssueProcessor()(base.Height(i+1), ip.GetStateFunc, nil, nil)
+		ip.MakeOperation(r.sender[0].Address(), r.sender[0].Priv(), items).RunPreProcess()
+		if err := ip.Error(); err != nil {
+			t.Fatal(err)
+		}
+
+		ip.RunProcess()
+		if err := ip.Error(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return r
+}
+
+func (r *revokeAllTest) active(t *testing.T, credentials int) int {
+	var n int
+
+	for i := 0; i < credentials; i++ {
+		cv, err := existsCredential(r.contract[0].Address(), "t1", fmt.Sprintf("credential%d", i), r.GetStateFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !cv.Status.IsTerminated() {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (r *revokeAllTest) cursor(t *testing.T, key string) state.RevocationCursorStateValue {
+	cursor, err := revocationCursor(key, r.GetStateFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cursor
+}
+
+func TestRevokeAllByTemplateInBatches(t *testing.T) {
+	defer func(max uint) { MaxRevokeAllCredentials = max }(MaxRevokeAllCredentials)
+	MaxRevokeAllCredentials = 2
+
+	r := newRevokeAllTest(t, 5)
+	ca := r.contract[0].Address()
+
+	revoke := func() error {
+		p := NewTestRevokeAllByTemplateProcessor(r.TestProcessor)
+		p.SetTemplate("t1").SetRevocation(types.RevocationReasonUnspecified, "")
+		p.Opr, _ = NewRevokeAllByTemplateProcessor()(base.Height(10), p.GetStateFunc, nil, nil)
+		p.MakeOperation(r.sender[0].Address(), r.sender[0].Priv(), ca, r.GenesisCurrency).RunPreProcess()
+		if err := p.Error(); err != nil {
+			return err
+		}
+
+		p.RunProcess()
+
+		return p.Error()
+	}
+
+	for i, expected := range []int{3, 1, 0} {
+		if err := revoke(); err != nil {
+			t.Fatalf("batch %d; %v", i, err)
+		}
+
+		if n := r.active(t, 5); n != expected {
+			t.Fatalf("batch %d; %d active credentials, not %d", i, n, expected)
+		}
+
+		if inProgress := r.cursor(t, state.StateKeyTemplateRevocationCursor(ca, "t1")).InProgress(); inProgress != (expected > 0) {
+			t.Fatalf("batch %d; revocation in progress, %v", i, inProgress)
+		}
+	}
+
+	// NOTE the next revocation starts over from the last credential page.
+	if err := revoke(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRevokeAllByHolderInBatches(t *testing.T) {
+	defer func(max uint) { MaxRevokeAllCredentials = max }(MaxRevokeAllCredentials)
+	MaxRevokeAllCredentials = 2
+
+	r := newRevokeAllTest(t, 5)
+	ca := r.contract[0].Address()
+
+	revoke := func() error {
+		p := NewTestRevokeAllByHolderProcessor(r.TestProcessor)
+		p.SetRevocation(types.RevocationReasonUnspecified, "").Create().
+			MakeOperation(r.sender[0].Address(), r.sender[0].Priv(), ca, r.holders[0].Address(), r.GenesisCurrency).
+			RunPreProcess()
+		if err := p.Error(); err != nil {
+			return err
+		}
+
+		p.RunProcess()
+
+		return p.Error()
+	}
+
+	// NOTE holder0 has 3 credentials of 5.
+	for i, expected := range []int{3, 2} {
+		if err := revoke(); err != nil {
+			t.Fatalf("batch %d; %v", i, err)
+		}
+
+		if n := r.active(t, 5); n != expected {
+			t.Fatalf("batch %d; %d active credentials, not %d", i, n, expected)
+		}
+	}
+
+	if err := revoke(); err == nil {
+		t.Fatal("holder without credentials revoked")
+	}
+}
+
+func TestCloseServiceInBatches(t *testing.T) {
+	defer func(max uint) { MaxRevokeAllCredentials = max }(MaxRevokeAllCredentials)
+	MaxRevokeAllCredentials = 2
+
+	r := newRevokeAllTest(t, 5)
+	ca := r.contract[0].Address()
+
+	closeService := func(revokeCredentials bool) error {
+		p := NewTestCloseServiceProcessor(r.TestProcessor)
+		p.SetRevokeCredentials(revokeCredentials)
+		p.Opr, _ = NewCloseServiceProcessor()(base.Height(10), p.GetStateFunc, nil, nil)
+		p.MakeOperation(r.sender[0].Address(), r.sender[0].Priv(), ca, r.GenesisCurrency).RunPreProcess()
+		if err := p.Error(); err != nil {
+			return err
+		}
+
+		p.RunProcess()
+
+		return p.Error()
+	}
+
+	for i, expected := range []int{3, 1, 0} {
+		if err := closeService(true); err != nil {
+			t.Fatalf("batch %d; %v", i, err)
+		}
+
+		if n := r.active(t, 5); n != expected {
+			t.Fatalf("batch %d; %d active credentials, not %d", i, n, expected)
+		}
+
+		if i == 0 {
+			if err := closeService(false); err == nil {
+				t.Fatal("closed service closed again without revocation")
+			}
+		}
+	}
+
+	if r.cursor(t, state.StateKeyServiceRevocationCursor(ca)).InProgress() {
+		t.Fatal("revocation in progress after all revoked")
+	}
+
+	if err := closeService(true); err == nil {
+		t.Fatal("closed service closed again after all revoked")
+	}
+}
@@ -20,6 +20,8 @@ type RevokeItem struct {
 	holder       base.Address
 	templateID   string
 	credentialID string
+	reason       types.RevocationReason
+	note         string
 	currency     crcytypes.CurrencyID
 }
 
@@ -27,6 +29,8 @@ func NewRevokeItem(
 	contract base.Address,
 	holder base.Address,
 	templateID, credentialID string,
+	reason types.RevocationReason,
+	note string,
 	currency crcytypes.CurrencyID,
 ) RevokeItem {
 	return RevokeItem{
@@ -35,6 +39,8 @@ func NewRevokeItem(
 		holder:       holder,
 		templateID:   templateID,
 		credentialID: credentialID,
+		reason:       reason,
+		note:         note,
 		currency:     currency,
 	}
 }
@@ -45,6 +51,8 @@ func (it RevokeItem) Bytes() []byte {
 		it.holder.Bytes(),
 		[]byte(it.templateID),
 		[]byte(it.credentialID),
+		it.reason.Bytes(),
+		[]byte(it.note),
 		it.currency.Bytes(),
 	)
 }
@@ -54,6 +62,7 @@ func (it RevokeItem) IsValid([]byte) error {
 		it.BaseHinter,
 		it.contract,
 		it.holder,
+		it.reason,
 		it.currency,
 	); err != nil {
 		return err
@@ -79,6 +88,10 @@ func (it RevokeItem) IsValid([]byte) error {
 		return common.ErrItemInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("credential ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", it.credentialID)))
 	}
 
+	if err := types.IsValidRevocationNote(it.note); err != nil {
+		return common.ErrItemInvalid.Wrap(err)
+	}
+
 	return nil
 }
 
@@ -98,6 +111,14 @@ func (it RevokeItem) CredentialID() string {
 	return it.credentialID
 }
 
+func (it RevokeItem) Reason() types.RevocationReason {
+	return it.reason
+}
+
+func (it RevokeItem) Note() string {
+	return it.note
+}
+
 func (it RevokeItem) Currency() crcytypes.CurrencyID {
 	return it.currency
 }
@@ -10,12 +10,14 @@ import (
 func (it RevokeItem) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":       it.Hint().String(),
-			"contract":    it.contract,
-			"holder":      it.holder,
-			"template_id": it.templateID,
-			"id":          it.credentialID,
-			"currency":    it.currency,
+			"_hint":         it.Hint().String(),
+			"contract":      it.contract,
+			"holder":        it.holder,
+			"template_id":   it.templateID,
+			"credential_id": it.credentialID,
+			"reason":        it.reason,
+			"note":          it.note,
+			"currency":      it.currency,
 		},
 	)
 }
@@ -26,6 +28,8 @@ type RevokeItemBSONUnmarshaler struct {
 	Holder       string `bson:"holder"`
 	TemplateID   string `bson:"template_id"`
 	CredentialID string `bson:"credential_id"`
+	Reason       string `bson:"reason"`
+	Note         string `bson:"note"`
 	Currency     string `bson:"currency"`
 }
 
@@ -45,6 +49,8 @@ func (it *RevokeItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		uit.Holder,
 		uit.TemplateID,
 		uit.CredentialID,
+		uit.Reason,
+		uit.Note,
 		uit.Currency,
 	); err != nil {
 		return common.DecorateError(err, common.ErrDecodeBson, *it)
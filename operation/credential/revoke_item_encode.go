@@ -1,6 +1,7 @@
 package credential
 
 import (
+	credentialtypes "github.com/ProtoconNet/mitum-credential/types"
 	"github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util/encoder"
@@ -9,10 +10,12 @@ import (
 
 func (it *RevokeItem) unpack(enc encoder.Encoder, ht hint.Hint,
 	cAdr, hAdr, tmplID string,
-	id, cid string,
+	id, reason, note, cid string,
 ) error {
 	it.BaseHinter = hint.NewBaseHinter(ht)
 	it.credentialID = id
+	it.reason = credentialtypes.RevocationReason(reason)
+	it.note = note
 	it.currency = types.CurrencyID(cid)
 
 	switch a, err := base.DecodeAddress(cAdr, enc); {
@@ -1,6 +1,7 @@
 package credential
 
 import (
+	credentialtypes "github.com/ProtoconNet/mitum-credential/types"
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	"github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
@@ -11,11 +12,13 @@ import (
 
 type RevokeItemJSONMarshaler struct {
 	hint.BaseHinter
-	Contract     base.Address     `json:"contract"`
-	Holder       base.Address     `json:"holder"`
-	TemplateID   string           `json:"template_id"`
-	CredentialID string           `json:"credential_id"`
-	Currency     types.CurrencyID `json:"currency"`
+	Contract     base.Address                     `json:"contract"`
+	Holder       base.Address                     `json:"holder"`
+	TemplateID   string                           `json:"template_id"`
+	CredentialID string                           `json:"credential_id"`
+	Reason       credentialtypes.RevocationReason `json:"reason,omitempty"`
+	Note         string                           `json:"note,omitempty"`
+	Currency     types.CurrencyID                 `json:"currency"`
 }
 
 func (it RevokeItem) MarshalJSON() ([]byte, error) {
@@ -25,6 +28,8 @@ func (it RevokeItem) MarshalJSON() ([]byte, error) {
 		Holder:       it.holder,
 		TemplateID:   it.templateID,
 		CredentialID: it.credentialID,
+		Reason:       it.reason,
+		Note:         it.note,
 		Currency:     it.currency,
 	})
 }
@@ -35,6 +40,8 @@ type RevokeItemJSONUnmarshaler struct {
 	Holder       string    `json:"holder"`
 	TemplateID   string    `json:"template_id"`
 	CredentialID string    `json:"credential_id"`
+	Reason       string    `json:"reason"`
+	Note         string    `json:"note"`
 	Currency     string    `json:"currency"`
 }
 
@@ -50,6 +57,8 @@ func (it *RevokeItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		uit.Holder,
 		uit.TemplateID,
 		uit.CredentialID,
+		uit.Reason,
+		uit.Note,
 		uit.Currency,
 	); err != nil {
 		return common.DecorateError(err, common.ErrDecodeJson, *it)
@@ -7,14 +7,10 @@ import (
 	"github.com/ProtoconNet/mitum-credential/state"
 	"github.com/ProtoconNet/mitum-credential/types"
 	"github.com/ProtoconNet/mitum-currency/v3/common"
-	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	cstate "github.com/ProtoconNet/mitum-currency/v3/state"
-	statec "github.com/ProtoconNet/mitum-currency/v3/state/currency"
-	stetee "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
-	"github.com/pkg/errors"
 )
 
 var revokeItemProcessorPool = sync.Pool{
@@ -36,11 +32,11 @@ func (Revoke) Process(
 }
 
 type RevokeItemProcessor struct {
-	h               util.Hash
-	sender          base.Address
-	item            RevokeItem
-	credentialCount *uint64
-	holders         *[]types.Holder
+	h      util.Hash
+	sender base.Address
+	height base.Height
+	item   RevokeItem
+	stats  *holderStats
 }
 
 func (ipp *RevokeItemProcessor) PreProcess(
@@ -53,74 +49,21 @@ func (ipp *RevokeItemProcessor) PreProcess(
 		return e.Wrap(err)
 	}
 
-	if err := cstate.CheckExistsState(statec.DesignStateKey(it.Currency()), getStateFunc); err != nil {
-		return e.Wrap(common.ErrCurrencyNF.Wrap(errors.Errorf("currency id %v", it.Currency())))
-	}
-
-	if _, _, aErr, cErr := cstate.ExistsCAccount(it.Holder(), "holder", true, false, getStateFunc); aErr != nil {
-		return e.Wrap(aErr)
-	} else if cErr != nil {
-		return e.Wrap(common.ErrCAccountNA.Wrap(cErr))
-	}
-
-	_, cSt, aErr, cErr := cstate.ExistsCAccount(it.Contract(), "contract", true, true, getStateFunc)
-	if aErr != nil {
-		return e.Wrap(aErr)
-	} else if cErr != nil {
-		return e.Wrap(cErr)
-	}
-
-	_, err := stetee.CheckCAAuthFromState(cSt, ipp.sender)
+	cv, err := checkIssuedCredential(
+		ipp.sender, it.Contract(), it.Holder(), it.TemplateID(), it.CredentialID(),
+		types.RoleRevoker, it.Currency(), getStateFunc)
 	if err != nil {
 		return e.Wrap(err)
 	}
 
-	if st, err := cstate.ExistsState(state.StateKeyDesign(it.Contract()), "design", getStateFunc); err != nil {
-		return e.Wrap(
-			common.ErrServiceNF.Errorf("credential design state for contract account %v", it.Contract()))
-	} else if de, err := state.StateDesignValue(st); err != nil {
-		return e.Wrap(
-			common.ErrServiceNF.Errorf("credential design state value for contract account %v", it.Contract()))
-	} else {
-		if err := de.IsValid(nil); err != nil {
-			return e.Wrap(err)
-		}
-		for i, v := range de.Policy().TemplateIDs() {
-			if it.templateID == v {
-				break
-			}
-			if i == len(de.Policy().TemplateIDs())-1 {
-				return e.Wrap(common.ErrValueInvalid.Errorf("not registered template %v", it.TemplateID()))
-			}
-		}
-	}
-
-	st, err := cstate.ExistsState(
-		state.StateKeyCredential(it.Contract(), it.TemplateID(), it.CredentialID()), "credential", getStateFunc)
-	if err != nil {
-		return e.Wrap(
-			common.ErrStateNF.Errorf(
-				"credential %v for template %v in contract account %v", it.CredentialID(), it.TemplateID(), it.Contract()))
-	}
-
-	crd, isActive, err := state.StateCredentialValue(st)
-	if err != nil {
-		return e.Wrap(
-			common.ErrStateValInvalid.Errorf(
-				"credential %v for template %v in contract account %v", it.CredentialID(), it.TemplateID(), it.Contract()))
-	}
-
-	if !crd.Holder().Equal(it.Holder()) {
-		return e.Wrap(
-			common.ErrValueInvalid.Errorf(
-				"holder %v has not owned credential %v for template %v in contract account %v",
-				it.Holder(), it.CredentialID(), it.TemplateID(), it.Contract()))
+	if err := checkServiceNotClosed(it.Contract(), getStateFunc); err != nil {
+		return e.Wrap(err)
 	}
 
-	if !isActive {
+	if cv.Status.IsTerminated() {
 		return e.Wrap(common.ErrValueInvalid.Errorf(
-			"already revoked credential %v for template %v in contract account %v",
-			it.CredentialID(), it.TemplateID(), it.Contract()))
+			"already %v credential %v for template %v in contract account %v",
+			cv.Status, it.CredentialID(), it.TemplateID(), it.Contract()))
 	}
 
 	return nil
@@ -129,57 +72,61 @@ func (ipp *RevokeItemProcessor) PreProcess(
 func (ipp *RevokeItemProcessor) Process(
 	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
 ) ([]base.StateMergeValue, error) {
-	if *ipp.credentialCount < 1 {
-		return nil, errors.Errorf("no credentials to revoke")
-	}
 	it := ipp.item
+	ref := types.NewCredentialRef(it.TemplateID(), it.CredentialID())
 
-	*ipp.credentialCount--
+	// NOTE the credential may be revoked already by the cascade of the
+	// previous item.
+	if ipp.stats.isRemoved(ref) {
+		return nil, nil
+	}
 
-	if len(*ipp.holders) < 1 {
-		return nil, errors.Errorf("empty holders, %s", it.Contract())
+	k := state.StateKeyCredential(it.Contract(), it.TemplateID(), it.CredentialID())
+
+	st, err := cstate.ExistsState(k, "credential", getStateFunc)
+	if err != nil {
+		return nil, err
 	}
 
-	st, _ := cstate.ExistsState(state.StateKeyCredential(it.Contract(), it.TemplateID(), it.CredentialID()), "credential", getStateFunc)
-	credential, _, _ := state.StateCredentialValue(st)
+	cv, err := state.StateCredentialStateValue(st)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := credential.IsValid(nil); err != nil {
+	if err := cv.Credential.IsValid(nil); err != nil {
 		return nil, err
 	}
 
+	if cv.Status.IsTerminated() {
+		return nil, common.ErrValueInvalid.Errorf(
+			"already %v credential %v for template %v in contract account %v",
+			cv.Status, it.CredentialID(), it.TemplateID(), it.Contract())
+	}
+
+	revocation := types.NewRevocation(it.Reason(), it.Note(), ipp.sender, ipp.height)
+
 	sts := []base.StateMergeValue{
-		cstate.NewStateMergeValue(
-			state.StateKeyCredential(it.Contract(), it.TemplateID(), it.CredentialID()),
-			state.NewCredentialStateValue(credential, false),
-		),
+		cstate.NewStateMergeValue(k, cv.SetStatus(types.CredentialStatusRevoked).SetRevocation(revocation)),
 	}
 
-	var holders []types.Holder
-	for i, h := range *ipp.holders {
-		if h.Address().Equal(it.Holder()) {
-			if h.CredentialCount()-1 == 0 {
-				copy(holders, (*ipp.holders)[:i])
-				copy(holders, (*ipp.holders)[i+1:])
-				ipp.holders = &holders
-			} else {
-				(*ipp.holders)[i] = types.NewHolder(h.Address(), h.CredentialCount()-1)
-			}
-			break
-		}
+	if err := ipp.stats.remove(cv.Credential.Holder(), ref, getStateFunc); err != nil {
+		return nil, err
+	}
 
-		if i == len(holders)-1 {
-			return nil, errors.Errorf("holder not found in credential service holders, %s, %s", it.Contract(), it.Holder())
-		}
+	cSts, err := cascadeRevocation(it.Contract(), cv.Credential.Holder(), ref, revocation, ipp.stats, getStateFunc)
+	if err != nil {
+		return nil, err
 	}
-	return sts, nil
+
+	return append(sts, cSts...), nil
 }
 
 func (ipp *RevokeItemProcessor) Close() {
 	ipp.h = nil
 	ipp.sender = nil
+	ipp.height = 0
 	ipp.item = RevokeItem{}
-	ipp.credentialCount = nil
-	ipp.holders = nil
+	ipp.stats = nil
 
 	revokeItemProcessorPool.Put(ipp)
 }
@@ -260,8 +207,7 @@ func (opp *RevokeProcessor) PreProcess(
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = it
-		ipc.credentialCount = nil
-		ipc.holders = nil
+		ipc.stats = nil
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError(
@@ -286,31 +232,22 @@ func (opp *RevokeProcessor) Process( // nolint:dupl
 		return nil, nil, e.Errorf("expected RevokeFact, not %T", op.Fact())
 	}
 
-	designs := map[string]types.Design{}
-	counters := map[string]*uint64{}
-	holders := map[string]*[]types.Holder{}
+	stats := map[string]*holderStats{}
 
 	for _, it := range fact.Items() {
 		k := state.StateKeyDesign(it.Contract())
 
-		if _, found := counters[k]; found {
+		if _, found := stats[k]; found {
 			continue
 		}
 
-		st, _ := cstate.ExistsState(k, "design", getStateFunc)
-
-		design, err := state.StateDesignValue(st)
+		hs, err := newHolderStats(it.Contract(), opp.Height(), getStateFunc)
 		if err != nil {
-			return nil, base.NewBaseOperationProcessReasonError("credential design value not found, %s; %w", it.Contract(), err), nil
+			return nil, base.NewBaseOperationProcessReasonError(
+				"credential design value not found, %s; %w", it.Contract(), err), nil
 		}
 
-		designs[k] = design
-
-		count := design.Policy().CredentialCount()
-		holder := design.Policy().Holders()
-
-		counters[k] = &count
-		holders[k] = &holder
+		stats[k] = hs
 	}
 
 	var sts []base.StateMergeValue // nolint:prealloc
@@ -322,37 +259,28 @@ func (opp *RevokeProcessor) Process( // nolint:dupl
 			return nil, nil, e.Errorf("expected RevokeItemProcessor, not %T", ip)
 		}
 
-		k := state.StateKeyDesign(it.Contract())
-
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
+		ipc.height = opp.Height()
 		ipc.item = it
-		ipc.credentialCount = counters[k]
-		ipc.holders = holders[k]
+		ipc.stats = stats[state.StateKeyDesign(it.Contract())]
 
 		st, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("failed to process RevokeItem; %w", err), nil
 		}
 
-		holders[k] = ipc.holders
 		sts = append(sts, st...)
 		ipc.Close()
 	}
 
-	for k, de := range designs {
-		policy := types.NewPolicy(de.Policy().TemplateIDs(), *holders[k], *counters[k])
-		design := types.NewDesign(policy)
-		if err := design.IsValid(nil); err != nil {
-			return nil, base.NewBaseOperationProcessReasonError("invalid design, %s; %w", k, err), nil
+	for _, k := range sortedHolderStatsKeys(stats) {
+		st, err := stats[k].states()
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
 		}
 
-		sts = append(sts,
-			cstate.NewStateMergeValue(
-				k,
-				state.NewDesignStateValue(design),
-			),
-		)
+		sts = append(sts, st...)
 	}
 
 	items := make([]CredentialItem, len(fact.Items()))
@@ -360,50 +288,11 @@ func (opp *RevokeProcessor) Process( // nolint:dupl
 		items[i] = fact.Items()[i]
 	}
 
-	feeReceiverBalSts, required, err := calculateCredentialItemsFee(getStateFunc, items)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
-	}
-	sb, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
-	}
-
-	for cid := range sb {
-		v, ok := sb[cid].Value().(statec.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Errorf("expected BalanceStateValue, not %T", sb[cid].Value())
-		}
-
-		_, feeReceiverFound := feeReceiverBalSts[cid]
-
-		if feeReceiverFound && (sb[cid].Key() != feeReceiverBalSts[cid].Key()) {
-			stmv := common.NewBaseStateMergeValue(
-				sb[cid].Key(),
-				statec.NewDeductBalanceStateValue(v.Amount.WithBig(required[cid][1])),
-				func(height base.Height, st base.State) base.StateValueMerger {
-					return statec.NewBalanceStateValueMerger(height, sb[cid].Key(), cid, st)
-				},
-			)
-
-			r, ok := feeReceiverBalSts[cid].Value().(statec.BalanceStateValue)
-			if !ok {
-				return nil, base.NewBaseOperationProcessReasonError("expected %T, not %T", statec.BalanceStateValue{}, feeReceiverBalSts[cid].Value()), nil
-			}
-			sts = append(
-				sts,
-				common.NewBaseStateMergeValue(
-					feeReceiverBalSts[cid].Key(),
-					statec.NewAddBalanceStateValue(r.Amount.WithBig(required[cid][1])),
-					func(height base.Height, st base.State) base.StateValueMerger {
-						return statec.NewBalanceStateValueMerger(height, feeReceiverBalSts[cid].Key(), cid, st)
-					},
-				),
-			)
-
-			sts = append(sts, stmv)
-		}
+	feeSts, rErr, err := processCredentialItemsFee(getStateFunc, fact.Sender(), items)
+	if rErr != nil || err != nil {
+		return nil, rErr, err
 	}
+	sts = append(sts, feeSts...)
 
 	return sts, nil, nil
 }
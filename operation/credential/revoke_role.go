@@ -0,0 +1,160 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RevokeRoleFactHint = hint.MustNewHint("mitum-credential-revoke-role-operation-fact-v0.0.1")
+	RevokeRoleHint     = hint.MustNewHint("mitum-credential-revoke-role-operation-v0.0.1")
+)
+
+type RevokeRoleFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	account    base.Address
+	roles      []types.Role
+	currency   crcytypes.CurrencyID
+}
+
+func NewRevokeRoleFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	account base.Address,
+	roles []types.Role,
+	currency crcytypes.CurrencyID,
+) RevokeRoleFact {
+	bf := base.NewBaseFact(RevokeRoleFactHint, token)
+	fact := RevokeRoleFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		account:    account,
+		roles:      roles,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RevokeRoleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RevokeRoleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RevokeRoleFact) Bytes() []byte {
+	rs := make([][]byte, len(fact.roles))
+	for i := range fact.roles {
+		rs[i] = fact.roles[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		fact.account.Bytes(),
+		util.ConcatBytesSlice(rs...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RevokeRoleFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.account,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > types.MaxLengthTemplateID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d, but %d", types.MaxLengthTemplateID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.templateID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.TemplateID())))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if fact.account.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("account %v is same with contract account", fact.account)))
+	}
+
+	if err := types.IsValidRoles(fact.roles); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RevokeRoleFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RevokeRoleFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RevokeRoleFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RevokeRoleFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact RevokeRoleFact) Account() base.Address {
+	return fact.account
+}
+
+func (fact RevokeRoleFact) Roles() []types.Role {
+	return fact.roles
+}
+
+func (fact RevokeRoleFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RevokeRoleFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 3)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	as[2] = fact.account
+	return as, nil
+}
+
+type RevokeRole struct {
+	common.BaseOperation
+}
+
+func NewRevokeRole(fact RevokeRoleFact) RevokeRole {
+	return RevokeRole{BaseOperation: common.NewBaseOperation(RevokeRoleHint, fact)}
+}
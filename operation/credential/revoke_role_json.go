@@ -0,0 +1,85 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type RevokeRoleFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	Account    base.Address             `json:"account"`
+	Roles      []types.Role             `json:"roles"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RevokeRoleFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeRoleFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		Account:               fact.account,
+		Roles:                 fact.roles,
+		Currency:              fact.currency,
+	})
+}
+
+type RevokeRoleFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string   `json:"sender"`
+	Contract   string   `json:"contract"`
+	TemplateID string   `json:"template_id"`
+	Account    string   `json:"account"`
+	Roles      []string `json:"roles"`
+	Currency   string   `json:"currency"`
+}
+
+func (fact *RevokeRoleFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf RevokeRoleFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.TemplateID,
+		uf.Account,
+		uf.Roles,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type RevokeRoleMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RevokeRole) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeRoleMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RevokeRole) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
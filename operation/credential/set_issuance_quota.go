@@ -0,0 +1,149 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	SetIssuanceQuotaFactHint = hint.MustNewHint("mitum-credential-set-issuance-quota-operation-fact-v0.0.1")
+	SetIssuanceQuotaHint     = hint.MustNewHint("mitum-credential-set-issuance-quota-operation-v0.0.1")
+)
+
+type SetIssuanceQuotaFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	quota      types.Quota
+	currency   crcytypes.CurrencyID
+}
+
+func NewSetIssuanceQuotaFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	quota types.Quota,
+	currency crcytypes.CurrencyID,
+) SetIssuanceQuotaFact {
+	bf := base.NewBaseFact(SetIssuanceQuotaFactHint, token)
+	fact := SetIssuanceQuotaFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		quota:      quota,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SetIssuanceQuotaFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SetIssuanceQuotaFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SetIssuanceQuotaFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		util.Uint64ToBytes(fact.quota.MaxCredentials()),
+		util.Uint64ToBytes(fact.quota.MaxIssuances()),
+		util.Uint64ToBytes(fact.quota.Period()),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact SetIssuanceQuotaFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if len(fact.templateID) > 0 {
+		if l := utf8.RuneCountInString(fact.templateID); l > types.MaxLengthTemplateID {
+			return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d, but %d", types.MaxLengthTemplateID, l)))
+		}
+
+		if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.templateID)) {
+			return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.TemplateID())))
+		}
+	}
+
+	if err := fact.quota.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact SetIssuanceQuotaFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SetIssuanceQuotaFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SetIssuanceQuotaFact) Contract() base.Address {
+	return fact.contract
+}
+
+// TemplateID returns the template whose issuance quota is set; the issuance
+// quota of the credential service is set if it is empty.
+func (fact SetIssuanceQuotaFact) TemplateID() string {
+	return fact.templateID
+}
+
+// Quota returns the issuance quota to set; the empty quota removes the
+// issuance quota.
+func (fact SetIssuanceQuotaFact) Quota() types.Quota {
+	return fact.quota
+}
+
+func (fact SetIssuanceQuotaFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact SetIssuanceQuotaFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type SetIssuanceQuota struct {
+	common.BaseOperation
+}
+
+func NewSetIssuanceQuota(fact SetIssuanceQuotaFact) SetIssuanceQuota {
+	return SetIssuanceQuota{BaseOperation: common.NewBaseOperation(SetIssuanceQuotaHint, fact)}
+}
@@ -0,0 +1,93 @@
+package credential // nolint: dupl
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact SetIssuanceQuotaFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":           fact.Hint().String(),
+			"sender":          fact.sender,
+			"contract":        fact.contract,
+			"template_id":     fact.templateID,
+			"max_credentials": fact.quota.MaxCredentials(),
+			"max_issuances":   fact.quota.MaxIssuances(),
+			"issuance_period": fact.quota.Period(),
+			"currency":        fact.currency,
+			"hash":            fact.BaseFact.Hash().String(),
+			"token":           fact.BaseFact.Token(),
+		},
+	)
+}
+
+type SetIssuanceQuotaFactBSONUnmarshaler struct {
+	Hint           string `bson:"_hint"`
+	Sender         string `bson:"sender"`
+	Contract       string `bson:"contract"`
+	TemplateID     string `bson:"template_id"`
+	MaxCredentials uint64 `bson:"max_credentials"`
+	MaxIssuances   uint64 `bson:"max_issuances"`
+	IssuancePeriod uint64 `bson:"issuance_period"`
+	Currency       string `bson:"currency"`
+}
+
+func (fact *SetIssuanceQuotaFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubf common.BaseFactBSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf SetIssuanceQuotaFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return common.DecorateError(err, common.ErrDecodeBson, *fact)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.TemplateID,
+		uf.MaxCredentials,
+		uf.MaxIssuances,
+		uf.IssuancePeriod,
+		uf.Currency)
+}
+
+func (op SetIssuanceQuota) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *SetIssuanceQuota) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of SetIssuanceQuota")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
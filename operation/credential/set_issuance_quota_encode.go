@@ -0,0 +1,34 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *SetIssuanceQuotaFact) unpack(enc encoder.Encoder,
+	sAdr, cAdr, tmplID string,
+	maxCredentials, maxIssuances, issuancePeriod uint64,
+	cid string,
+) error {
+	fact.templateID = tmplID
+	fact.quota = types.NewQuota(maxCredentials, maxIssuances, issuancePeriod)
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	return nil
+}
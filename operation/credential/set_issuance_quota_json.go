@@ -0,0 +1,88 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type SetIssuanceQuotaFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner          base.Address             `json:"sender"`
+	Contract       base.Address             `json:"contract"`
+	TemplateID     string                   `json:"template_id"`
+	MaxCredentials uint64                   `json:"max_credentials"`
+	MaxIssuances   uint64                   `json:"max_issuances"`
+	IssuancePeriod uint64                   `json:"issuance_period"`
+	Currency       currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact SetIssuanceQuotaFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SetIssuanceQuotaFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		MaxCredentials:        fact.quota.MaxCredentials(),
+		MaxIssuances:          fact.quota.MaxIssuances(),
+		IssuancePeriod:        fact.quota.Period(),
+		Currency:              fact.currency,
+	})
+}
+
+type SetIssuanceQuotaFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner          string `json:"sender"`
+	Contract       string `json:"contract"`
+	TemplateID     string `json:"template_id"`
+	MaxCredentials uint64 `json:"max_credentials"`
+	MaxIssuances   uint64 `json:"max_issuances"`
+	IssuancePeriod uint64 `json:"issuance_period"`
+	Currency       string `json:"currency"`
+}
+
+func (fact *SetIssuanceQuotaFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf SetIssuanceQuotaFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.TemplateID,
+		uf.MaxCredentials,
+		uf.MaxIssuances,
+		uf.IssuancePeriod,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type SetIssuanceQuotaMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op SetIssuanceQuota) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SetIssuanceQuotaMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *SetIssuanceQuota) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
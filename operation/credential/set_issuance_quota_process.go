@@ -0,0 +1,202 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var setIssuanceQuotaProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(SetIssuanceQuotaProcessor)
+	},
+}
+
+func (SetIssuanceQuota) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type SetIssuanceQuotaProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewSetIssuanceQuotaProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new SetIssuanceQuotaProcessor")
+
+		nopp := setIssuanceQuotaProcessorPool.Get()
+		opp, ok := nopp.(*SetIssuanceQuotaProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected SetIssuanceQuotaProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *SetIssuanceQuotaProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(SetIssuanceQuotaFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", SetIssuanceQuotaFact{}, op.Fact())), nil
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Currency())), nil
+	}
+
+	if _, _, aErr, cErr := currencystate.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).
+				Errorf("%v: sender %v is contract account", cErr, fact.Sender())), nil
+	}
+
+	if len(fact.TemplateID()) < 1 {
+		_, cSt, aErr, cErr := currencystate.ExistsCAccount(fact.Contract(), "contract", true, true, getStateFunc)
+		if aErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Errorf("%v", aErr)), nil
+		} else if cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Errorf("%v", cErr)), nil
+		}
+
+		if _, err := extensioncurrency.CheckCAAuthFromState(cSt, fact.Sender()); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Errorf("%v", err)), nil
+		}
+
+		if err := currencystate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Wrap(common.ErrMServiceNF).
+					Errorf("credential design for contract account %v", fact.Contract())), nil
+		}
+	} else if _, err := checkServiceTemplate(
+		fact.Sender(), fact.Contract(), fact.TemplateID(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := checkServiceNotClosed(fact.Contract(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMSignInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *SetIssuanceQuotaProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process SetIssuanceQuota")
+
+	fact, ok := op.Fact().(SetIssuanceQuotaFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected SetIssuanceQuotaFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue
+
+	if len(fact.TemplateID()) < 1 {
+		st, err := currencystate.ExistsState(state.StateKeyDesign(fact.Contract()), "design", getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("credential design not found, %q; %w", fact.Contract(), err), nil
+		}
+
+		design, err := state.StateDesignValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("credential design value not found, %q; %w", fact.Contract(), err), nil
+		}
+
+		design = design.SetQuota(fact.Quota())
+		if err := design.IsValid(nil); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("invalid credential design, %q; %w", fact.Contract(), err), nil
+		}
+
+		sts = append(sts, currencystate.NewStateMergeValue(
+			state.StateKeyDesign(fact.Contract()),
+			state.NewDesignStateValue(design),
+		))
+	} else {
+		template, err := existsTemplate(fact.Contract(), fact.TemplateID(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+
+		template = template.SetQuota(fact.Quota())
+		if err := template.IsValid(nil); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("invalid template, %q; %w", fact.TemplateID(), err), nil
+		}
+
+		sts = append(sts, currencystate.NewStateMergeValue(
+			state.StateKeyTemplate(fact.Contract(), fact.TemplateID()),
+			state.NewTemplateStateValue(template),
+		))
+	}
+
+	feeSts, rErr, err := processCredentialItemsFee(getStateFunc, fact.Sender(), []CredentialItem{fact})
+	if rErr != nil || err != nil {
+		return nil, rErr, err
+	}
+
+	return append(sts, feeSts...), nil, nil
+}
+
+func (opp *SetIssuanceQuotaProcessor) Close() error {
+	setIssuanceQuotaProcessorPool.Put(opp)
+
+	return nil
+}
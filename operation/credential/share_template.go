@@ -0,0 +1,154 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ShareTemplateFactHint = hint.MustNewHint("mitum-credential-share-template-operation-fact-v0.0.1")
+	ShareTemplateHint     = hint.MustNewHint("mitum-credential-share-template-operation-v0.0.1")
+)
+
+type ShareTemplateFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	grantees   []base.Address
+	currency   crcytypes.CurrencyID
+}
+
+func NewShareTemplateFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	grantees []base.Address,
+	currency crcytypes.CurrencyID,
+) ShareTemplateFact {
+	bf := base.NewBaseFact(ShareTemplateFactHint, token)
+	fact := ShareTemplateFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		grantees:   grantees,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ShareTemplateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ShareTemplateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ShareTemplateFact) Bytes() []byte {
+	gs := make([][]byte, len(fact.grantees))
+	for i := range fact.grantees {
+		gs[i] = fact.grantees[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		util.ConcatBytesSlice(gs...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ShareTemplateFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > types.MaxLengthTemplateID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d, but %d", types.MaxLengthTemplateID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.templateID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.TemplateID())))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := types.IsValidTemplateGrantees(true, fact.grantees); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	for i := range fact.grantees {
+		if fact.grantees[i].Equal(fact.contract) {
+			return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("grantee %v is same with contract account", fact.grantees[i])))
+		}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ShareTemplateFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ShareTemplateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ShareTemplateFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact ShareTemplateFact) TemplateID() string {
+	return fact.templateID
+}
+
+// Grantees returns the contract accounts to be allowed to issue credentials
+// under the template; it replaces the current grant list.
+func (fact ShareTemplateFact) Grantees() []base.Address {
+	return fact.grantees
+}
+
+func (fact ShareTemplateFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact ShareTemplateFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type ShareTemplate struct {
+	common.BaseOperation
+}
+
+func NewShareTemplate(fact ShareTemplateFact) ShareTemplate {
+	return ShareTemplate{BaseOperation: common.NewBaseOperation(ShareTemplateHint, fact)}
+}
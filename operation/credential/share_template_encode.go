@@ -0,0 +1,41 @@
+package credential
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *ShareTemplateFact) unpack(enc encoder.Encoder,
+	sAdr, cAdr, tmplID string,
+	grantees []string,
+	cid string,
+) error {
+	fact.templateID = tmplID
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	fact.grantees = make([]base.Address, len(grantees))
+	for i := range grantees {
+		a, err := base.DecodeAddress(grantees[i], enc)
+		if err != nil {
+			return err
+		}
+		fact.grantees[i] = a
+	}
+
+	return nil
+}
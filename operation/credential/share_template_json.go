@@ -0,0 +1,80 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type ShareTemplateFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	Grantees   []base.Address           `json:"grantees"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact ShareTemplateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ShareTemplateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		Grantees:              fact.grantees,
+		Currency:              fact.currency,
+	})
+}
+
+type ShareTemplateFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string   `json:"sender"`
+	Contract   string   `json:"contract"`
+	TemplateID string   `json:"template_id"`
+	Grantees   []string `json:"grantees"`
+	Currency   string   `json:"currency"`
+}
+
+func (fact *ShareTemplateFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf ShareTemplateFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.TemplateID,
+		uf.Grantees,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type ShareTemplateMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op ShareTemplate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ShareTemplateMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ShareTemplate) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
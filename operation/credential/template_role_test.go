@@ -0,0 +1,109 @@
+package credential
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+func TestTemplateRoleKeepsHandlers(t *testing.T) {
+	tp := &test.TestProcessor{}
+	tp.Setup(test.NewMockStateGetter())
+
+	sender := make([]test.Account, 1)
+	handler := make([]test.Account, 1)
+	issuer := make([]test.Account, 1)
+	contract := make([]test.Account, 1)
+
+	p := NewTestGrantRoleProcessor(tp)
+	p.SetAccount(tp.NewPrivateKey("sender"), 1000000000, tp.GenesisCurrency, sender, true).
+		SetAccount(tp.NewPrivateKey("handler"), 1000000000, tp.GenesisCurrency, handler, true).
+		SetAccount(tp.NewPrivateKey("issuer"), 1000000000, tp.GenesisCurrency, issuer, true).
+		SetContractAccount(sender[0].Address(), tp.NewPrivateKey("contract"), 1000, tp.GenesisCurrency, contract, true).
+		SetService(contract[0].Address(), types.NewTemplate(
+			"t1", "template", "2024-01-01", "2099-12-31", false, false,
+			"template", "subject", "template", sender[0].Address(), "", "", nil, 0,
+		))
+
+	ca := contract[0].Address()
+
+	cst, _, _ := tp.MockGetter.Get(extension.StateKeyContractAccount(ca))
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := status.SetHandlers([]base.Address{handler[0].Address()}); err != nil {
+		t.Fatal(err)
+	}
+
+	cst = common.NewBaseState(
+		base.Height(1), extension.StateKeyContractAccount(ca), extension.NewContractAccountStateValue(status),
+		nil, []util.Hash{})
+	tp.SetState(cst, true)
+
+	checkRole := func(account test.Account) error {
+		return checkTemplateRole(cst, account.Address(), ca, "t1", types.RoleIssuer, tp.GetStateFunc)
+	}
+
+	if err := checkRole(handler[0]); err != nil {
+		t.Fatalf("handler without roles; %v", err)
+	}
+
+	if err := checkRole(issuer[0]); err == nil {
+		t.Fatal("account without role permitted")
+	}
+
+	grant := func(sender test.Account, roles []types.Role, restrict bool) error {
+		p.SetTemplate("t1", issuer[0], roles).SetRestrict(restrict).
+			Create().MakeOperation(sender.Address(), sender.Priv(), ca, tp.GenesisCurrency).
+			RunPreProcess()
+
+		if err := p.Error(); err != nil {
+			return err
+		}
+
+		p.RunProcess()
+
+		return p.Error()
+	}
+
+	// NOTE granting roles keeps the authority of handlers.
+	if err := grant(sender[0], []types.Role{types.RoleIssuer}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := checkRole(issuer[0]); err != nil {
+		t.Fatalf("issuer; %v", err)
+	}
+
+	if err := checkRole(handler[0]); err != nil {
+		t.Fatalf("handler after role granted; %v", err)
+	}
+
+	if err := grant(handler[0], []types.Role{types.RoleRevoker}, true); err == nil {
+		t.Fatal("handler restricted template")
+	}
+
+	// NOTE the owner restricts the template to the role-based access.
+	if err := grant(sender[0], []types.Role{types.RoleRevoker}, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := checkRole(issuer[0]); err != nil {
+		t.Fatalf("issuer of restricted template; %v", err)
+	}
+
+	if err := checkRole(handler[0]); err == nil {
+		t.Fatal("handler permitted on restricted template")
+	}
+
+	if err := checkRole(sender[0]); err != nil {
+		t.Fatalf("owner of restricted template; %v", err)
+	}
+}
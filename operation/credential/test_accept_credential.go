@@ -0,0 +1,158 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestAcceptCredentialProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[AcceptCredential]
+	templateID   string
+	credentialID string
+}
+
+func NewTestAcceptCredentialProcessor(tp *test.TestProcessor) TestAcceptCredentialProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[AcceptCredential](tp)
+	return TestAcceptCredentialProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestAcceptCredentialProcessor) Create() *TestAcceptCredentialProcessor {
+	t.Opr, _ = NewAcceptCredentialProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestAcceptCredentialProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestAcceptCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestAcceptCredentialProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestAcceptCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestAcceptCredentialProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestAcceptCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestAcceptCredentialProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestAcceptCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestAcceptCredentialProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestAcceptCredentialProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestAcceptCredentialProcessor) LoadOperation(fileName string,
+) *TestAcceptCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestAcceptCredentialProcessor) Print(fileName string,
+) *TestAcceptCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestAcceptCredentialProcessor) SetTemplate(
+	templateID, credentialID string,
+) *TestAcceptCredentialProcessor {
+	t.templateID = templateID
+	t.credentialID = credentialID
+
+	return t
+}
+
+func (t *TestAcceptCredentialProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestAcceptCredentialProcessor {
+	op := NewAcceptCredential(
+		NewAcceptCredentialFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.templateID,
+			t.credentialID,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestAcceptCredentialProcessor) RunPreProcess() *TestAcceptCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestAcceptCredentialProcessor) RunProcess() *TestAcceptCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestAcceptCredentialProcessor) IsValid() *TestAcceptCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestAcceptCredentialProcessor) Decode(fileName string) *TestAcceptCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
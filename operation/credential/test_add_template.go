@@ -13,15 +13,26 @@ import (
 
 type TestAddTemplateProcessor struct {
 	*test.BaseTestOperationProcessorNoItem[AddTemplate]
-	templateID     string
-	templateName   string
-	serviceDate    types.Date
-	expirationDate types.Date
-	templateShare  types.Bool
-	multiAudit     types.Bool
-	displayName    string
-	subjectKey     string
-	description    string
+	templateID       string
+	templateName     string
+	serviceDate      types.Date
+	expirationDate   types.Date
+	templateShare    types.Bool
+	multiAudit       types.Bool
+	displayName      string
+	subjectKey       string
+	description      string
+	schema           string
+	schemaHash       string
+	auditors         []base.Address
+	auditThreshold   uint64
+	offerLifetime    uint64
+	prerequisites    []string
+	cascade          types.Bool
+	maxPerHolder     uint64
+	uniqueValue      types.Bool
+	serviceHeight    base.Height
+	expirationHeight base.Height
 }
 
 func NewTestAddTemplateProcessor(tp *test.TestProcessor) TestAddTemplateProcessor {
@@ -74,9 +85,8 @@ func (t *TestAddTemplateProcessor) SetService(
 	contract base.Address,
 ) *TestAddTemplateProcessor {
 	var templates []string
-	var holders []types.Holder
 
-	policy := types.NewPolicy(templates, holders, 0)
+	policy := types.NewPolicy(templates, 0, 0)
 	design := types.NewDesign(policy)
 
 	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
@@ -129,6 +139,50 @@ func (t *TestAddTemplateProcessor) SetTemplate(
 	return t
 }
 
+func (t *TestAddTemplateProcessor) SetSchema(schema, schemaHash string) *TestAddTemplateProcessor {
+	t.schema = schema
+	t.schemaHash = schemaHash
+
+	return t
+}
+
+func (t *TestAddTemplateProcessor) SetAuditors(auditors []test.Account, auditThreshold uint64) *TestAddTemplateProcessor {
+	t.auditors = make([]base.Address, len(auditors))
+	for i := range auditors {
+		t.auditors[i] = auditors[i].Address()
+	}
+	t.auditThreshold = auditThreshold
+
+	return t
+}
+
+func (t *TestAddTemplateProcessor) SetOfferLifetime(lifetime uint64) *TestAddTemplateProcessor {
+	t.offerLifetime = lifetime
+
+	return t
+}
+
+func (t *TestAddTemplateProcessor) SetPrerequisites(prerequisites []string, cascade types.Bool) *TestAddTemplateProcessor {
+	t.prerequisites = prerequisites
+	t.cascade = cascade
+
+	return t
+}
+
+func (t *TestAddTemplateProcessor) SetIssuanceLimits(maxPerHolder uint64, uniqueValue types.Bool) *TestAddTemplateProcessor {
+	t.maxPerHolder = maxPerHolder
+	t.uniqueValue = uniqueValue
+
+	return t
+}
+
+func (t *TestAddTemplateProcessor) SetServiceHeights(serviceHeight, expirationHeight base.Height) *TestAddTemplateProcessor {
+	t.serviceHeight = serviceHeight
+	t.expirationHeight = expirationHeight
+
+	return t
+}
+
 func (t *TestAddTemplateProcessor) MakeOperation(
 	sender base.Address, privatekey base.Privatekey, contract, creator base.Address, currency ctypes.CurrencyID,
 ) *TestAddTemplateProcessor {
@@ -147,6 +201,17 @@ func (t *TestAddTemplateProcessor) MakeOperation(
 			t.subjectKey,
 			t.description,
 			creator,
+			t.schema,
+			t.schemaHash,
+			t.auditors,
+			t.auditThreshold,
+			t.offerLifetime,
+			t.prerequisites,
+			t.cascade,
+			t.maxPerHolder,
+			t.uniqueValue,
+			t.serviceHeight,
+			t.expirationHeight,
 			currency,
 		))
 	_ = op.Sign(privatekey, t.NetworkID)
@@ -0,0 +1,161 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestAmendCredentialProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[AmendCredential]
+	templateID   string
+	credentialID string
+	value        string
+}
+
+func NewTestAmendCredentialProcessor(tp *test.TestProcessor) TestAmendCredentialProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[AmendCredential](tp)
+	return TestAmendCredentialProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestAmendCredentialProcessor) Create() *TestAmendCredentialProcessor {
+	t.Opr, _ = NewAmendCredentialProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestAmendCredentialProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestAmendCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestAmendCredentialProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestAmendCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestAmendCredentialProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestAmendCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestAmendCredentialProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestAmendCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestAmendCredentialProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestAmendCredentialProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestAmendCredentialProcessor) LoadOperation(fileName string,
+) *TestAmendCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestAmendCredentialProcessor) Print(fileName string,
+) *TestAmendCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestAmendCredentialProcessor) SetTemplate(
+	templateID, credentialID, value string,
+) *TestAmendCredentialProcessor {
+	t.templateID = templateID
+	t.credentialID = credentialID
+	t.value = value
+
+	return t
+}
+
+func (t *TestAmendCredentialProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestAmendCredentialProcessor {
+	op := NewAmendCredential(
+		NewAmendCredentialFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.templateID,
+			t.credentialID,
+			t.value,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestAmendCredentialProcessor) RunPreProcess() *TestAmendCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestAmendCredentialProcessor) RunProcess() *TestAmendCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestAmendCredentialProcessor) IsValid() *TestAmendCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestAmendCredentialProcessor) Decode(fileName string) *TestAmendCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
@@ -0,0 +1,194 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestApproveCredentialRequestProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[ApproveCredentialRequest]
+	templateID       string
+	id               string
+	value            string
+	validFrom        uint64
+	validUntil       uint64
+	did              string
+	templateContract base.Address
+	requestID        string
+}
+
+func NewTestApproveCredentialRequestProcessor(tp *test.TestProcessor) TestApproveCredentialRequestProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[ApproveCredentialRequest](tp)
+	return TestApproveCredentialRequestProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestApproveCredentialRequestProcessor) Create() *TestApproveCredentialRequestProcessor {
+	t.Opr, _ = NewApproveCredentialRequestProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestApproveCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestApproveCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestApproveCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestApproveCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestApproveCredentialRequestProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) LoadOperation(fileName string,
+) *TestApproveCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) Print(fileName string,
+) *TestApproveCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) SetTemplate(
+	templateID,
+	id,
+	value string,
+	validFrom,
+	validUntil uint64,
+	did string,
+) *TestApproveCredentialRequestProcessor {
+	t.templateID = templateID
+	t.id = id
+	t.value = value
+	t.validFrom = validFrom
+	t.validUntil = validUntil
+	t.did = did
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) SetRequest(requestID string) *TestApproveCredentialRequestProcessor {
+	t.requestID = requestID
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) SetTemplateContract(contract base.Address) *TestApproveCredentialRequestProcessor {
+	t.templateContract = contract
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract, holder base.Address, currency ctypes.CurrencyID,
+) *TestApproveCredentialRequestProcessor {
+	op := NewApproveCredentialRequest(
+		NewApproveCredentialRequestFact(
+			[]byte("token"),
+			sender,
+			t.requestID,
+			NewIssueItem(
+				contract,
+				holder,
+				t.templateID,
+				t.id,
+				t.value,
+				t.validFrom,
+				t.validUntil,
+				t.did,
+				t.templateContract,
+				currency,
+			),
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) RunPreProcess() *TestApproveCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) RunProcess() *TestApproveCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) IsValid() *TestApproveCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestApproveCredentialRequestProcessor) Decode(fileName string) *TestApproveCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
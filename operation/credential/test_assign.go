@@ -13,12 +13,13 @@ import (
 
 type TestIssueProcessor struct {
 	*test.BaseTestOperationProcessorWithItem[Issue, IssueItem]
-	templateID string
-	id         string
-	value      string
-	validFrom  uint64
-	validUntil uint64
-	did        string
+	templateID       string
+	id               string
+	value            string
+	validFrom        uint64
+	validUntil       uint64
+	did              string
+	templateContract base.Address
 }
 
 func NewTestIssueProcessor(tp *test.TestProcessor) TestIssueProcessor {
@@ -71,9 +72,8 @@ func (t *TestIssueProcessor) SetService(
 	contract base.Address,
 ) *TestIssueProcessor {
 	var templates []string
-	var holders []credentialtypes.Holder
 
-	policy := credentialtypes.NewPolicy(templates, holders, 0)
+	policy := credentialtypes.NewPolicy(templates, 0, 0)
 	design := credentialtypes.NewDesign(policy)
 
 	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
@@ -127,6 +127,12 @@ func (t *TestIssueProcessor) SetTemplate(
 	return t
 }
 
+func (t *TestIssueProcessor) SetTemplateContract(contract base.Address) *TestIssueProcessor {
+	t.templateContract = contract
+
+	return t
+}
+
 func (t *TestIssueProcessor) MakeItem(
 	contract, holder test.Account, currency types.CurrencyID, targetItems []IssueItem,
 ) *TestIssueProcessor {
@@ -139,6 +145,7 @@ func (t *TestIssueProcessor) MakeItem(
 		t.validFrom,
 		t.validUntil,
 		t.did,
+		t.templateContract,
 		currency,
 	)
 	test.UpdateSlice[IssueItem](item, targetItems)
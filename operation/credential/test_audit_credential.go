@@ -0,0 +1,181 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	credentialtypes "github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestAuditCredentialProcessor struct {
+	*test.BaseTestOperationProcessorWithItem[AuditCredential, AuditCredentialItem]
+	templateID string
+	id         string
+}
+
+func NewTestAuditCredentialProcessor(tp *test.TestProcessor) TestAuditCredentialProcessor {
+	t := test.NewBaseTestOperationProcessorWithItem[AuditCredential, AuditCredentialItem](tp)
+	return TestAuditCredentialProcessor{BaseTestOperationProcessorWithItem: &t}
+}
+
+func (t *TestAuditCredentialProcessor) Create() *TestAuditCredentialProcessor {
+	t.Opr, _ = NewAuditCredentialProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestAuditCredentialProcessor {
+	t.BaseTestOperationProcessorWithItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestAuditCredentialProcessor {
+	t.BaseTestOperationProcessorWithItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestAuditCredentialProcessor {
+	t.BaseTestOperationProcessorWithItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestAuditCredentialProcessor {
+	t.BaseTestOperationProcessorWithItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) LoadOperation(fileName string,
+) *TestAuditCredentialProcessor {
+	t.BaseTestOperationProcessorWithItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) Print(fileName string,
+) *TestAuditCredentialProcessor {
+	t.BaseTestOperationProcessorWithItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) SetTemplate(
+	templateID,
+	id string,
+) *TestAuditCredentialProcessor {
+	t.templateID = templateID
+	t.id = id
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) SetService(
+	contract base.Address, template credentialtypes.Template,
+) *TestAuditCredentialProcessor {
+	policy := credentialtypes.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := credentialtypes.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) SetCredential(
+	contract base.Address, credential credentialtypes.Credential, approvals []base.Address,
+) *TestAuditCredentialProcessor {
+	cv := state.NewCredentialStateValue(credential, credentialtypes.CredentialStatusPending).SetApprovals(approvals)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyCredential(contract, credential.TemplateID(), credential.CredentialID()), cv, nil, []util.Hash{})
+	t.SetState(st, true)
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) MakeItem(
+	contract, holder test.Account, currency types.CurrencyID, targetItems []AuditCredentialItem,
+) *TestAuditCredentialProcessor {
+	item := NewAuditCredentialItem(
+		contract.Address(),
+		holder.Address(),
+		t.templateID,
+		t.id,
+		currency,
+	)
+	test.UpdateSlice[AuditCredentialItem](item, targetItems)
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, items []AuditCredentialItem,
+) *TestAuditCredentialProcessor {
+	op := NewAuditCredential(
+		NewAuditCredentialFact(
+			[]byte("token"),
+			sender,
+			items,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) RunPreProcess() *TestAuditCredentialProcessor {
+	t.BaseTestOperationProcessorWithItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) RunProcess() *TestAuditCredentialProcessor {
+	t.BaseTestOperationProcessorWithItem.RunProcess()
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) IsValid() *TestAuditCredentialProcessor {
+	t.BaseTestOperationProcessorWithItem.IsValid()
+
+	return t
+}
+
+func (t *TestAuditCredentialProcessor) Decode(fileName string) *TestAuditCredentialProcessor {
+	t.BaseTestOperationProcessorWithItem.Decode(fileName)
+
+	return t
+}
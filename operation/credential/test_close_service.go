@@ -0,0 +1,153 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestCloseServiceProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[CloseService]
+	revokeCredentials bool
+}
+
+func NewTestCloseServiceProcessor(tp *test.TestProcessor) TestCloseServiceProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[CloseService](tp)
+	return TestCloseServiceProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestCloseServiceProcessor) Create() *TestCloseServiceProcessor {
+	t.Opr, _ = NewCloseServiceProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestCloseServiceProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestCloseServiceProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestCloseServiceProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestCloseServiceProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestCloseServiceProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestCloseServiceProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestCloseServiceProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestCloseServiceProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestCloseServiceProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestCloseServiceProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestCloseServiceProcessor) LoadOperation(fileName string,
+) *TestCloseServiceProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestCloseServiceProcessor) Print(fileName string,
+) *TestCloseServiceProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestCloseServiceProcessor) SetRevokeCredentials(revokeCredentials bool) *TestCloseServiceProcessor {
+	t.revokeCredentials = revokeCredentials
+
+	return t
+}
+
+func (t *TestCloseServiceProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestCloseServiceProcessor {
+	op := NewCloseService(
+		NewCloseServiceFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.revokeCredentials,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestCloseServiceProcessor) RunPreProcess() *TestCloseServiceProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestCloseServiceProcessor) RunProcess() *TestCloseServiceProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestCloseServiceProcessor) IsValid() *TestCloseServiceProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestCloseServiceProcessor) Decode(fileName string) *TestCloseServiceProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
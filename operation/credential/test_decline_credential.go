@@ -0,0 +1,158 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestDeclineCredentialProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[DeclineCredential]
+	templateID   string
+	credentialID string
+}
+
+func NewTestDeclineCredentialProcessor(tp *test.TestProcessor) TestDeclineCredentialProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[DeclineCredential](tp)
+	return TestDeclineCredentialProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestDeclineCredentialProcessor) Create() *TestDeclineCredentialProcessor {
+	t.Opr, _ = NewDeclineCredentialProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestDeclineCredentialProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestDeclineCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestDeclineCredentialProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestDeclineCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestDeclineCredentialProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestDeclineCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestDeclineCredentialProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestDeclineCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestDeclineCredentialProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestDeclineCredentialProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestDeclineCredentialProcessor) LoadOperation(fileName string,
+) *TestDeclineCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestDeclineCredentialProcessor) Print(fileName string,
+) *TestDeclineCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestDeclineCredentialProcessor) SetTemplate(
+	templateID, credentialID string,
+) *TestDeclineCredentialProcessor {
+	t.templateID = templateID
+	t.credentialID = credentialID
+
+	return t
+}
+
+func (t *TestDeclineCredentialProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestDeclineCredentialProcessor {
+	op := NewDeclineCredential(
+		NewDeclineCredentialFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.templateID,
+			t.credentialID,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestDeclineCredentialProcessor) RunPreProcess() *TestDeclineCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestDeclineCredentialProcessor) RunProcess() *TestDeclineCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestDeclineCredentialProcessor) IsValid() *TestDeclineCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestDeclineCredentialProcessor) Decode(fileName string) *TestDeclineCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
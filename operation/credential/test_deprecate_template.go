@@ -0,0 +1,155 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestDeprecateTemplateProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[DeprecateTemplate]
+	templateID string
+}
+
+func NewTestDeprecateTemplateProcessor(tp *test.TestProcessor) TestDeprecateTemplateProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[DeprecateTemplate](tp)
+	return TestDeprecateTemplateProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestDeprecateTemplateProcessor) Create() *TestDeprecateTemplateProcessor {
+	t.Opr, _ = NewDeprecateTemplateProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestDeprecateTemplateProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestDeprecateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestDeprecateTemplateProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestDeprecateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestDeprecateTemplateProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestDeprecateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestDeprecateTemplateProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestDeprecateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestDeprecateTemplateProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestDeprecateTemplateProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestDeprecateTemplateProcessor) LoadOperation(fileName string,
+) *TestDeprecateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestDeprecateTemplateProcessor) Print(fileName string,
+) *TestDeprecateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestDeprecateTemplateProcessor) SetTemplate(
+	templateID string,
+) *TestDeprecateTemplateProcessor {
+	t.templateID = templateID
+
+	return t
+}
+
+func (t *TestDeprecateTemplateProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestDeprecateTemplateProcessor {
+	op := NewDeprecateTemplate(
+		NewDeprecateTemplateFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.templateID,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestDeprecateTemplateProcessor) RunPreProcess() *TestDeprecateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestDeprecateTemplateProcessor) RunProcess() *TestDeprecateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestDeprecateTemplateProcessor) IsValid() *TestDeprecateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestDeprecateTemplateProcessor) Decode(fileName string) *TestDeprecateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
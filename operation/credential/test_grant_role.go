@@ -0,0 +1,169 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestGrantRoleProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[GrantRole]
+	templateID string
+	account    base.Address
+	roles      []types.Role
+	restrict   types.Bool
+}
+
+func NewTestGrantRoleProcessor(tp *test.TestProcessor) TestGrantRoleProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[GrantRole](tp)
+	return TestGrantRoleProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestGrantRoleProcessor) Create() *TestGrantRoleProcessor {
+	t.Opr, _ = NewGrantRoleProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestGrantRoleProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestGrantRoleProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) LoadOperation(fileName string,
+) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) Print(fileName string,
+) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) SetTemplate(
+	templateID string, account test.Account, roles []types.Role,
+) *TestGrantRoleProcessor {
+	t.templateID = templateID
+	t.account = account.Address()
+	t.roles = roles
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) SetRestrict(restrict bool) *TestGrantRoleProcessor {
+	t.restrict = types.Bool(restrict)
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestGrantRoleProcessor {
+	op := NewGrantRole(
+		NewGrantRoleFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.templateID,
+			t.account,
+			t.roles,
+			t.restrict,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) RunPreProcess() *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) RunProcess() *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) IsValid() *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestGrantRoleProcessor) Decode(fileName string) *TestGrantRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
@@ -0,0 +1,304 @@
+package credential
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	credentialtypes "github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+// TestHolderStatProcessor runs random sequences of issue and revoke operations
+// against one credential service and checks the policy counters, the
+// holder-stat states and the credential pages after every operation. Every
+// other operation is processed at the next height.
+type TestHolderStatProcessor struct {
+	*test.TestProcessor
+	rand       *rand.Rand
+	sender     []test.Account
+	contract   []test.Account
+	holders    []test.Account
+	currency   types.CurrencyID
+	templateID string
+	issued     map[string]int
+	revoked    map[string]int
+	serial     int
+	height     base.Height
+}
+
+func NewTestHolderStatProcessor(tp *test.TestProcessor, seed int64) TestHolderStatProcessor {
+	return TestHolderStatProcessor{
+		TestProcessor: tp,
+		rand:          rand.New(rand.NewSource(seed)), // nolint:gosec
+		height:        base.GenesisHeight,
+		templateID:    "template",
+		issued:        map[string]int{},
+		revoked:       map[string]int{},
+	}
+}
+
+func (t *TestHolderStatProcessor) SetService(holders int) *TestHolderStatProcessor {
+	t.currency = t.GenesisCurrency
+	t.sender = make([]test.Account, 1)
+	t.contract = make([]test.Account, 1)
+	t.holders = make([]test.Account, holders)
+
+	t.SetAccount(t.NewPrivateKey("sender"), 1000000000, t.currency, t.sender, true)
+	t.SetContractAccount(t.sender[0].Address(), t.NewPrivateKey("contract"), 1000, t.currency, t.contract, true)
+
+	for i := range t.holders {
+		t.SetAccount(t.NewPrivateKey(fmt.Sprintf("holder%d", i)), 1000, t.currency, t.holders[i:i+1], true)
+	}
+
+	contract := t.contract[0].Address()
+
+	template := credentialtypes.NewTemplate(
+		t.templateID, "template", "2024-01-01", "2099-12-31", false, false,
+		"template", "subject", "template", t.sender[0].Address(), "", "", nil, 0,
+	)
+
+	policy := credentialtypes.NewPolicy([]string{t.templateID}, 0, 0)
+	design := credentialtypes.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, t.templateID), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+// Run processes n random operations and stops at the first operation error
+// or counter mismatch.
+func (t *TestHolderStatProcessor) Run(n int) error {
+	for i := 0; i < n; i++ {
+		if t.rand.Intn(2) == 0 {
+			t.height++
+		}
+
+		var err error
+		if len(t.issued) > 0 && t.rand.Intn(3) == 0 {
+			err = t.revoke()
+		} else {
+			err = t.issue()
+		}
+
+		if err != nil {
+			return errors.WithMessagef(err, "step %d", i)
+		}
+
+		if err := t.Check(); err != nil {
+			return errors.WithMessagef(err, "step %d", i)
+		}
+	}
+
+	return nil
+}
+
+func (t *TestHolderStatProcessor) issue() error {
+	picked := map[string]int{}
+
+	revoked := sortedCredentialIDs(t.revoked)
+	for i := t.rand.Intn(4); i >= 0; i-- {
+		id := fmt.Sprintf("credential%d", t.serial)
+		if len(revoked) > 0 && t.rand.Intn(4) == 0 {
+			id = revoked[t.rand.Intn(len(revoked))]
+		} else {
+			t.serial++
+		}
+
+		if _, found := picked[id]; found {
+			continue
+		}
+
+		picked[id] = t.rand.Intn(len(t.holders))
+	}
+
+	ids := sortedCredentialIDs(picked)
+	items := make([]IssueItem, len(ids))
+
+	p := NewTestIssueProcessor(t.TestProcessor)
+	for i, id := range ids {
+		p.SetTemplate(t.templateID, id, "value", 1735689600, 1767225600, "did").
+			MakeItem(t.contract[0], t.holders[picked[id]], t.currency, items[i:i+1])
+	}
+
+	if err := t.process(
+		func() {
+			p.Opr, _ = NewIssueProcessor()(t.height, p.GetStateFunc, nil, nil)
+			p.MakeOperation(t.sender[0].Address(), t.sender[0].Priv(), items).IsValid()
+		},
+		func() { p.RunPreProcess() },
+		func() { p.RunProcess() },
+	); err != nil {
+		return errors.WithMessage(err, "issue")
+	}
+
+	for _, id := range ids {
+		delete(t.revoked, id)
+		t.issued[id] = picked[id]
+	}
+
+	return nil
+}
+
+func (t *TestHolderStatProcessor) revoke() error {
+	picked := map[string]int{}
+
+	issued := sortedCredentialIDs(t.issued)
+	for i := t.rand.Intn(4); i >= 0; i-- {
+		id := issued[t.rand.Intn(len(issued))]
+		picked[id] = t.issued[id]
+	}
+
+	ids := sortedCredentialIDs(picked)
+	items := make([]RevokeItem, len(ids))
+
+	p := NewTestRevokeProcessor(t.TestProcessor)
+	for i, id := range ids {
+		p.SetTemplate(t.templateID, id).
+			SetRevocation(credentialtypes.RevocationReasonUnspecified, "").
+			MakeItem(t.contract[0], t.holders[picked[id]], t.currency, items[i:i+1])
+	}
+
+	if err := t.process(
+		func() {
+			p.Opr, _ = NewRevokeProcessor()(t.height, p.GetStateFunc, nil, nil)
+			p.MakeOperation(t.sender[0].Address(), t.sender[0].Priv(), items).IsValid()
+		},
+		func() { p.RunPreProcess() },
+		func() { p.RunProcess() },
+	); err != nil {
+		return errors.WithMessage(err, "revoke")
+	}
+
+	for _, id := range ids {
+		delete(t.issued, id)
+		t.revoked[id] = picked[id]
+	}
+
+	return nil
+}
+
+func (t *TestHolderStatProcessor) process(steps ...func()) error {
+	for i := range steps {
+		steps[i]()
+
+		if err := t.Error(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Check compares the policy counters, the holder-stat states and the
+// credential pages with the credentials issued and revoked so far.
+func (t *TestHolderStatProcessor) Check() error {
+	contract := t.contract[0].Address()
+
+	st, found, _ := t.MockGetter.Get(state.StateKeyDesign(contract))
+	if !found {
+		return errors.Errorf("design not found")
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return err
+	}
+
+	counts := make([]uint64, len(t.holders))
+	for _, h := range t.issued {
+		counts[h]++
+	}
+
+	var holderCount uint64
+	for i := range t.holders {
+		if counts[i] > 0 {
+			holderCount++
+		}
+
+		var count uint64
+		if st, found, _ := t.MockGetter.Get(state.StateKeyHolderStat(contract, t.holders[i].Address())); found {
+			h, err := state.StateHolderStatValue(st)
+			if err != nil {
+				return err
+			}
+			count = h.CredentialCount()
+		}
+
+		if count != counts[i] {
+			return errors.Errorf("credential count of holder %v, %d != %d", t.holders[i].Address(), count, counts[i])
+		}
+	}
+
+	if c := design.Policy().CredentialCount(); c != uint64(len(t.issued)) {
+		return errors.Errorf("credential count, %d != %d", c, len(t.issued))
+	}
+
+	if c := design.Policy().HolderCount(); c != holderCount {
+		return errors.Errorf("holder count, %d != %d", c, holderCount)
+	}
+
+	last, err := lastCredentialPage(contract, t.templateID, t.GetStateFunc)
+	if err != nil {
+		return err
+	}
+
+	refs, _, err := nextCredentials(
+		contract, state.NewRevocationCursorStateValue(t.templateID, last, 0), math.MaxInt, t.GetStateFunc)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]int, len(refs))
+	for i := range refs {
+		cv, err := existsCredential(contract, t.templateID, refs[i].CredentialID(), t.GetStateFunc)
+		if err != nil {
+			return err
+		}
+
+		if !cv.Status.IsTerminated() {
+			ids[refs[i].CredentialID()] = 0
+		}
+	}
+
+	if a, b := sortedCredentialIDs(ids), sortedCredentialIDs(t.issued); fmt.Sprint(a) != fmt.Sprint(b) {
+		return errors.Errorf("credentials in pages, %v != %v", a, b)
+	}
+
+	return nil
+}
+
+func sortedCredentialIDs(m map[string]int) []string {
+	ids := make([]string, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
@@ -0,0 +1,196 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestIndexCredentialsProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[IndexCredentials]
+	credentials []types.CredentialRef
+}
+
+func NewTestIndexCredentialsProcessor(tp *test.TestProcessor) TestIndexCredentialsProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[IndexCredentials](tp)
+	return TestIndexCredentialsProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestIndexCredentialsProcessor) Create() *TestIndexCredentialsProcessor {
+	t.Opr, _ = NewIndexCredentialsProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestIndexCredentialsProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestIndexCredentialsProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestIndexCredentialsProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestIndexCredentialsProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestIndexCredentialsProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestIndexCredentialsProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestIndexCredentialsProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestIndexCredentialsProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestIndexCredentialsProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestIndexCredentialsProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestIndexCredentialsProcessor) LoadOperation(fileName string,
+) *TestIndexCredentialsProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestIndexCredentialsProcessor) Print(fileName string,
+) *TestIndexCredentialsProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+// SetLegacyCredential sets the active credential issued by the previous
+// versions, which is counted in the credential service but not indexed.
+func (t *TestIndexCredentialsProcessor) SetLegacyCredential(
+	contract base.Address, credential types.Credential,
+) *TestIndexCredentialsProcessor {
+	dst, found, _ := t.MockGetter.Get(state.StateKeyDesign(contract))
+	if !found {
+		panic("design not set")
+	}
+	design, err := state.StateDesignValue(dst)
+	if err != nil {
+		panic(err)
+	}
+
+	var count uint64
+	if hst, found, _ := t.MockGetter.Get(state.StateKeyHolderStat(contract, credential.Holder())); found {
+		h, err := state.StateHolderStatValue(hst)
+		if err != nil {
+			panic(err)
+		}
+		count = h.CredentialCount()
+	}
+
+	policy := design.Policy()
+	holderCount := policy.HolderCount()
+	if count < 1 {
+		holderCount++
+	}
+
+	npolicy := types.NewPolicy(policy.TemplateIDs(), holderCount, policy.CredentialCount()+1).
+		SetUnindexedCount(policy.UnindexedCount() + 1)
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design.SetPolicy(npolicy)), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	hst := common.NewBaseState(base.Height(1), state.StateKeyHolderStat(contract, credential.Holder()), state.NewHolderStatStateValue(types.NewHolder(credential.Holder(), count+1)), nil, []util.Hash{})
+	t.SetState(hst, true)
+
+	cst := common.NewBaseState(base.Height(1), state.StateKeyCredential(contract, credential.TemplateID(), credential.CredentialID()), state.NewCredentialStateValue(credential, types.CredentialStatusActive), nil, []util.Hash{})
+	t.SetState(cst, true)
+
+	return t
+}
+
+func (t *TestIndexCredentialsProcessor) SetCredentials(credentials []types.CredentialRef) *TestIndexCredentialsProcessor {
+	t.credentials = credentials
+
+	return t
+}
+
+func (t *TestIndexCredentialsProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestIndexCredentialsProcessor {
+	op := NewIndexCredentials(
+		NewIndexCredentialsFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.credentials,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestIndexCredentialsProcessor) RunPreProcess() *TestIndexCredentialsProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestIndexCredentialsProcessor) RunProcess() *TestIndexCredentialsProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestIndexCredentialsProcessor) IsValid() *TestIndexCredentialsProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestIndexCredentialsProcessor) Decode(fileName string) *TestIndexCredentialsProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
@@ -0,0 +1,186 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestOfferCredentialProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[OfferCredential]
+	templateID       string
+	id               string
+	value            string
+	validFrom        uint64
+	validUntil       uint64
+	did              string
+	templateContract base.Address
+}
+
+func NewTestOfferCredentialProcessor(tp *test.TestProcessor) TestOfferCredentialProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[OfferCredential](tp)
+	return TestOfferCredentialProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestOfferCredentialProcessor) Create() *TestOfferCredentialProcessor {
+	t.Opr, _ = NewOfferCredentialProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestOfferCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestOfferCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestOfferCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestOfferCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestOfferCredentialProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) LoadOperation(fileName string,
+) *TestOfferCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) Print(fileName string,
+) *TestOfferCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) SetTemplate(
+	templateID,
+	id,
+	value string,
+	validFrom,
+	validUntil uint64,
+	did string,
+) *TestOfferCredentialProcessor {
+	t.templateID = templateID
+	t.id = id
+	t.value = value
+	t.validFrom = validFrom
+	t.validUntil = validUntil
+	t.did = did
+
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) SetTemplateContract(contract base.Address) *TestOfferCredentialProcessor {
+	t.templateContract = contract
+
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract, holder base.Address, currency ctypes.CurrencyID,
+) *TestOfferCredentialProcessor {
+	op := NewOfferCredential(
+		NewOfferCredentialFact(
+			[]byte("token"),
+			sender,
+			NewIssueItem(
+				contract,
+				holder,
+				t.templateID,
+				t.id,
+				t.value,
+				t.validFrom,
+				t.validUntil,
+				t.did,
+				t.templateContract,
+				currency,
+			),
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) RunPreProcess() *TestOfferCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) RunProcess() *TestOfferCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) IsValid() *TestOfferCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestOfferCredentialProcessor) Decode(fileName string) *TestOfferCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
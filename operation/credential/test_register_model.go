@@ -65,9 +65,8 @@ func (t *TestRegisterModelProcessor) SetService(
 	contract base.Address,
 ) *TestRegisterModelProcessor {
 	var templates []string
-	var holders []credentialtypes.Holder
 
-	policy := credentialtypes.NewPolicy(templates, holders, 0)
+	policy := credentialtypes.NewPolicy(templates, 0, 0)
 	design := credentialtypes.NewDesign(policy)
 
 	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
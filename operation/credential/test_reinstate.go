@@ -0,0 +1,169 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	credentialtypes "github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestReinstateProcessor struct {
+	*test.BaseTestOperationProcessorWithItem[Reinstate, ReinstateItem]
+	templateID string
+	id         string
+}
+
+func NewTestReinstateProcessor(tp *test.TestProcessor) TestReinstateProcessor {
+	t := test.NewBaseTestOperationProcessorWithItem[Reinstate, ReinstateItem](tp)
+	return TestReinstateProcessor{BaseTestOperationProcessorWithItem: &t}
+}
+
+func (t *TestReinstateProcessor) Create() *TestReinstateProcessor {
+	t.Opr, _ = NewReinstateProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestReinstateProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestReinstateProcessor {
+	t.BaseTestOperationProcessorWithItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestReinstateProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestReinstateProcessor {
+	t.BaseTestOperationProcessorWithItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestReinstateProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestReinstateProcessor {
+	t.BaseTestOperationProcessorWithItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestReinstateProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestReinstateProcessor {
+	t.BaseTestOperationProcessorWithItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestReinstateProcessor) LoadOperation(fileName string,
+) *TestReinstateProcessor {
+	t.BaseTestOperationProcessorWithItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestReinstateProcessor) Print(fileName string,
+) *TestReinstateProcessor {
+	t.BaseTestOperationProcessorWithItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestReinstateProcessor) SetTemplate(
+	templateID,
+	id string,
+) *TestReinstateProcessor {
+	t.templateID = templateID
+	t.id = id
+
+	return t
+}
+
+func (t *TestReinstateProcessor) SetService(
+	contract base.Address,
+) *TestReinstateProcessor {
+	var templates []string
+
+	policy := credentialtypes.NewPolicy(templates, 0, 0)
+	design := credentialtypes.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestReinstateProcessor) MakeItem(
+	contract, holder test.Account, currency types.CurrencyID, targetItems []ReinstateItem,
+) *TestReinstateProcessor {
+	item := NewReinstateItem(
+		contract.Address(),
+		holder.Address(),
+		t.templateID,
+		t.id,
+		currency,
+	)
+	test.UpdateSlice[ReinstateItem](item, targetItems)
+
+	return t
+}
+
+func (t *TestReinstateProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, items []ReinstateItem,
+) *TestReinstateProcessor {
+	op := NewReinstate(
+		NewReinstateFact(
+			[]byte("token"),
+			sender,
+			items,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestReinstateProcessor) RunPreProcess() *TestReinstateProcessor {
+	t.BaseTestOperationProcessorWithItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestReinstateProcessor) RunProcess() *TestReinstateProcessor {
+	t.BaseTestOperationProcessorWithItem.RunProcess()
+
+	return t
+}
+
+func (t *TestReinstateProcessor) IsValid() *TestReinstateProcessor {
+	t.BaseTestOperationProcessorWithItem.IsValid()
+
+	return t
+}
+
+func (t *TestReinstateProcessor) Decode(fileName string) *TestReinstateProcessor {
+	t.BaseTestOperationProcessorWithItem.Decode(fileName)
+
+	return t
+}
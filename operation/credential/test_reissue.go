@@ -0,0 +1,194 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestReissueProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[Reissue]
+	templateID       string
+	id               string
+	value            string
+	validFrom        uint64
+	validUntil       uint64
+	did              string
+	templateContract base.Address
+	supersedes       string
+}
+
+func NewTestReissueProcessor(tp *test.TestProcessor) TestReissueProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[Reissue](tp)
+	return TestReissueProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestReissueProcessor) Create() *TestReissueProcessor {
+	t.Opr, _ = NewReissueProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestReissueProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestReissueProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestReissueProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestReissueProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestReissueProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestReissueProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestReissueProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestReissueProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestReissueProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestReissueProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestReissueProcessor) LoadOperation(fileName string,
+) *TestReissueProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestReissueProcessor) Print(fileName string,
+) *TestReissueProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestReissueProcessor) SetTemplate(
+	templateID,
+	id,
+	value string,
+	validFrom,
+	validUntil uint64,
+	did string,
+) *TestReissueProcessor {
+	t.templateID = templateID
+	t.id = id
+	t.value = value
+	t.validFrom = validFrom
+	t.validUntil = validUntil
+	t.did = did
+
+	return t
+}
+
+func (t *TestReissueProcessor) SetSupersedes(credentialID string) *TestReissueProcessor {
+	t.supersedes = credentialID
+
+	return t
+}
+
+func (t *TestReissueProcessor) SetTemplateContract(contract base.Address) *TestReissueProcessor {
+	t.templateContract = contract
+
+	return t
+}
+
+func (t *TestReissueProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract, holder base.Address, currency ctypes.CurrencyID,
+) *TestReissueProcessor {
+	op := NewReissue(
+		NewReissueFact(
+			[]byte("token"),
+			sender,
+			t.supersedes,
+			NewIssueItem(
+				contract,
+				holder,
+				t.templateID,
+				t.id,
+				t.value,
+				t.validFrom,
+				t.validUntil,
+				t.did,
+				t.templateContract,
+				currency,
+			),
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestReissueProcessor) RunPreProcess() *TestReissueProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestReissueProcessor) RunProcess() *TestReissueProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestReissueProcessor) IsValid() *TestReissueProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestReissueProcessor) Decode(fileName string) *TestReissueProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
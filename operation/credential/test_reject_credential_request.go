@@ -0,0 +1,161 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestRejectCredentialRequestProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[RejectCredentialRequest]
+	templateID string
+	requestID  string
+	reason     string
+}
+
+func NewTestRejectCredentialRequestProcessor(tp *test.TestProcessor) TestRejectCredentialRequestProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[RejectCredentialRequest](tp)
+	return TestRejectCredentialRequestProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestRejectCredentialRequestProcessor) Create() *TestRejectCredentialRequestProcessor {
+	t.Opr, _ = NewRejectCredentialRequestProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestRejectCredentialRequestProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestRejectCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestRejectCredentialRequestProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestRejectCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestRejectCredentialRequestProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestRejectCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRejectCredentialRequestProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestRejectCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRejectCredentialRequestProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestRejectCredentialRequestProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestRejectCredentialRequestProcessor) LoadOperation(fileName string,
+) *TestRejectCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestRejectCredentialRequestProcessor) Print(fileName string,
+) *TestRejectCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestRejectCredentialRequestProcessor) SetTemplate(
+	templateID, requestID, reason string,
+) *TestRejectCredentialRequestProcessor {
+	t.templateID = templateID
+	t.requestID = requestID
+	t.reason = reason
+
+	return t
+}
+
+func (t *TestRejectCredentialRequestProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestRejectCredentialRequestProcessor {
+	op := NewRejectCredentialRequest(
+		NewRejectCredentialRequestFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.templateID,
+			t.requestID,
+			t.reason,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestRejectCredentialRequestProcessor) RunPreProcess() *TestRejectCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestRejectCredentialRequestProcessor) RunProcess() *TestRejectCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestRejectCredentialRequestProcessor) IsValid() *TestRejectCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestRejectCredentialRequestProcessor) Decode(fileName string) *TestRejectCredentialRequestProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
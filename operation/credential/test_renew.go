@@ -0,0 +1,182 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	credentialtypes "github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestRenewProcessor struct {
+	*test.BaseTestOperationProcessorWithItem[Renew, RenewItem]
+	templateID string
+	id         string
+	validFrom  uint64
+	validUntil uint64
+}
+
+func NewTestRenewProcessor(tp *test.TestProcessor) TestRenewProcessor {
+	t := test.NewBaseTestOperationProcessorWithItem[Renew, RenewItem](tp)
+	return TestRenewProcessor{BaseTestOperationProcessorWithItem: &t}
+}
+
+func (t *TestRenewProcessor) Create() *TestRenewProcessor {
+	t.Opr, _ = NewRenewProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestRenewProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestRenewProcessor {
+	t.BaseTestOperationProcessorWithItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestRenewProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestRenewProcessor {
+	t.BaseTestOperationProcessorWithItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestRenewProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestRenewProcessor {
+	t.BaseTestOperationProcessorWithItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRenewProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestRenewProcessor {
+	t.BaseTestOperationProcessorWithItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRenewProcessor) LoadOperation(fileName string,
+) *TestRenewProcessor {
+	t.BaseTestOperationProcessorWithItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestRenewProcessor) Print(fileName string,
+) *TestRenewProcessor {
+	t.BaseTestOperationProcessorWithItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestRenewProcessor) SetTemplate(
+	templateID,
+	id string,
+) *TestRenewProcessor {
+	t.templateID = templateID
+	t.id = id
+
+	return t
+}
+
+func (t *TestRenewProcessor) SetValidity(
+	validFrom, validUntil uint64,
+) *TestRenewProcessor {
+	t.validFrom = validFrom
+	t.validUntil = validUntil
+
+	return t
+}
+
+func (t *TestRenewProcessor) SetService(
+	contract base.Address,
+) *TestRenewProcessor {
+	var templates []string
+
+	policy := credentialtypes.NewPolicy(templates, 0, 0)
+	design := credentialtypes.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestRenewProcessor) MakeItem(
+	contract, holder test.Account, currency types.CurrencyID, targetItems []RenewItem,
+) *TestRenewProcessor {
+	item := NewRenewItem(
+		contract.Address(),
+		holder.Address(),
+		t.templateID,
+		t.id,
+		t.validFrom,
+		t.validUntil,
+		currency,
+	)
+	test.UpdateSlice[RenewItem](item, targetItems)
+
+	return t
+}
+
+func (t *TestRenewProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, items []RenewItem,
+) *TestRenewProcessor {
+	op := NewRenew(
+		NewRenewFact(
+			[]byte("token"),
+			sender,
+			items,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestRenewProcessor) RunPreProcess() *TestRenewProcessor {
+	t.BaseTestOperationProcessorWithItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestRenewProcessor) RunProcess() *TestRenewProcessor {
+	t.BaseTestOperationProcessorWithItem.RunProcess()
+
+	return t
+}
+
+func (t *TestRenewProcessor) IsValid() *TestRenewProcessor {
+	t.BaseTestOperationProcessorWithItem.IsValid()
+
+	return t
+}
+
+func (t *TestRenewProcessor) Decode(fileName string) *TestRenewProcessor {
+	t.BaseTestOperationProcessorWithItem.Decode(fileName)
+
+	return t
+}
@@ -0,0 +1,161 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestRequestCredentialProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[RequestCredential]
+	templateID string
+	requestID  string
+	value      string
+}
+
+func NewTestRequestCredentialProcessor(tp *test.TestProcessor) TestRequestCredentialProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[RequestCredential](tp)
+	return TestRequestCredentialProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestRequestCredentialProcessor) Create() *TestRequestCredentialProcessor {
+	t.Opr, _ = NewRequestCredentialProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestRequestCredentialProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestRequestCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestRequestCredentialProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestRequestCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestRequestCredentialProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestRequestCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRequestCredentialProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestRequestCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRequestCredentialProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestRequestCredentialProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestRequestCredentialProcessor) LoadOperation(fileName string,
+) *TestRequestCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestRequestCredentialProcessor) Print(fileName string,
+) *TestRequestCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestRequestCredentialProcessor) SetTemplate(
+	templateID, requestID, value string,
+) *TestRequestCredentialProcessor {
+	t.templateID = templateID
+	t.requestID = requestID
+	t.value = value
+
+	return t
+}
+
+func (t *TestRequestCredentialProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestRequestCredentialProcessor {
+	op := NewRequestCredential(
+		NewRequestCredentialFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.templateID,
+			t.requestID,
+			t.value,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestRequestCredentialProcessor) RunPreProcess() *TestRequestCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestRequestCredentialProcessor) RunProcess() *TestRequestCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestRequestCredentialProcessor) IsValid() *TestRequestCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestRequestCredentialProcessor) Decode(fileName string) *TestRequestCredentialProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
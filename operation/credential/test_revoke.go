@@ -15,6 +15,8 @@ type TestRevokeProcessor struct {
 	*test.BaseTestOperationProcessorWithItem[Revoke, RevokeItem]
 	templateID string
 	id         string
+	reason     credentialtypes.RevocationReason
+	note       string
 }
 
 func NewTestRevokeProcessor(tp *test.TestProcessor) TestRevokeProcessor {
@@ -87,13 +89,22 @@ func (t *TestRevokeProcessor) SetTemplate(
 	return t
 }
 
+func (t *TestRevokeProcessor) SetRevocation(
+	reason credentialtypes.RevocationReason,
+	note string,
+) *TestRevokeProcessor {
+	t.reason = reason
+	t.note = note
+
+	return t
+}
+
 func (t *TestRevokeProcessor) SetService(
 	contract base.Address,
 ) *TestRevokeProcessor {
 	var templates []string
-	var holders []credentialtypes.Holder
 
-	policy := credentialtypes.NewPolicy(templates, holders, 0)
+	policy := credentialtypes.NewPolicy(templates, 0, 0)
 	design := credentialtypes.NewDesign(policy)
 
 	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
@@ -123,6 +134,8 @@ func (t *TestRevokeProcessor) MakeItem(
 		holder.Address(),
 		t.templateID,
 		t.id,
+		t.reason,
+		t.note,
 		currency,
 	)
 	test.UpdateSlice[RevokeItem](item, targetItems)
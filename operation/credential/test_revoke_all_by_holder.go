@@ -0,0 +1,159 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestRevokeAllByHolderProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[RevokeAllByHolder]
+	reason types.RevocationReason
+	note   string
+}
+
+func NewTestRevokeAllByHolderProcessor(tp *test.TestProcessor) TestRevokeAllByHolderProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[RevokeAllByHolder](tp)
+	return TestRevokeAllByHolderProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestRevokeAllByHolderProcessor) Create() *TestRevokeAllByHolderProcessor {
+	t.Opr, _ = NewRevokeAllByHolderProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestRevokeAllByHolderProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestRevokeAllByHolderProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestRevokeAllByHolderProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestRevokeAllByHolderProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestRevokeAllByHolderProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestRevokeAllByHolderProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRevokeAllByHolderProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestRevokeAllByHolderProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRevokeAllByHolderProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestRevokeAllByHolderProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestRevokeAllByHolderProcessor) LoadOperation(fileName string,
+) *TestRevokeAllByHolderProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestRevokeAllByHolderProcessor) Print(fileName string,
+) *TestRevokeAllByHolderProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestRevokeAllByHolderProcessor) SetRevocation(
+	reason types.RevocationReason, note string,
+) *TestRevokeAllByHolderProcessor {
+	t.reason = reason
+	t.note = note
+
+	return t
+}
+
+func (t *TestRevokeAllByHolderProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract, holder base.Address, currency ctypes.CurrencyID,
+) *TestRevokeAllByHolderProcessor {
+	op := NewRevokeAllByHolder(
+		NewRevokeAllByHolderFact(
+			[]byte("token"),
+			sender,
+			contract,
+			holder,
+			t.reason,
+			t.note,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestRevokeAllByHolderProcessor) RunPreProcess() *TestRevokeAllByHolderProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestRevokeAllByHolderProcessor) RunProcess() *TestRevokeAllByHolderProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestRevokeAllByHolderProcessor) IsValid() *TestRevokeAllByHolderProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestRevokeAllByHolderProcessor) Decode(fileName string) *TestRevokeAllByHolderProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
@@ -0,0 +1,168 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestRevokeAllByTemplateProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[RevokeAllByTemplate]
+	templateID string
+	reason     types.RevocationReason
+	note       string
+}
+
+func NewTestRevokeAllByTemplateProcessor(tp *test.TestProcessor) TestRevokeAllByTemplateProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[RevokeAllByTemplate](tp)
+	return TestRevokeAllByTemplateProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestRevokeAllByTemplateProcessor) Create() *TestRevokeAllByTemplateProcessor {
+	t.Opr, _ = NewRevokeAllByTemplateProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestRevokeAllByTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestRevokeAllByTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestRevokeAllByTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestRevokeAllByTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestRevokeAllByTemplateProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) LoadOperation(fileName string,
+) *TestRevokeAllByTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) Print(fileName string,
+) *TestRevokeAllByTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) SetTemplate(
+	templateID string,
+) *TestRevokeAllByTemplateProcessor {
+	t.templateID = templateID
+
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) SetRevocation(
+	reason types.RevocationReason, note string,
+) *TestRevokeAllByTemplateProcessor {
+	t.reason = reason
+	t.note = note
+
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestRevokeAllByTemplateProcessor {
+	op := NewRevokeAllByTemplate(
+		NewRevokeAllByTemplateFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.templateID,
+			t.reason,
+			t.note,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) RunPreProcess() *TestRevokeAllByTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) RunProcess() *TestRevokeAllByTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) IsValid() *TestRevokeAllByTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestRevokeAllByTemplateProcessor) Decode(fileName string) *TestRevokeAllByTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
@@ -0,0 +1,161 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestRevokeRoleProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[RevokeRole]
+	templateID string
+	account    base.Address
+	roles      []types.Role
+}
+
+func NewTestRevokeRoleProcessor(tp *test.TestProcessor) TestRevokeRoleProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[RevokeRole](tp)
+	return TestRevokeRoleProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestRevokeRoleProcessor) Create() *TestRevokeRoleProcessor {
+	t.Opr, _ = NewRevokeRoleProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestRevokeRoleProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) LoadOperation(fileName string,
+) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) Print(fileName string,
+) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) SetTemplate(
+	templateID string, account test.Account, roles []types.Role,
+) *TestRevokeRoleProcessor {
+	t.templateID = templateID
+	t.account = account.Address()
+	t.roles = roles
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestRevokeRoleProcessor {
+	op := NewRevokeRole(
+		NewRevokeRoleFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.templateID,
+			t.account,
+			t.roles,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) RunPreProcess() *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) RunProcess() *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) IsValid() *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestRevokeRoleProcessor) Decode(fileName string) *TestRevokeRoleProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
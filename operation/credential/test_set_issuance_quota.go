@@ -0,0 +1,165 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestSetIssuanceQuotaProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[SetIssuanceQuota]
+	templateID string
+	quota      types.Quota
+}
+
+func NewTestSetIssuanceQuotaProcessor(tp *test.TestProcessor) TestSetIssuanceQuotaProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[SetIssuanceQuota](tp)
+	return TestSetIssuanceQuotaProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestSetIssuanceQuotaProcessor) Create() *TestSetIssuanceQuotaProcessor {
+	t.Opr, _ = NewSetIssuanceQuotaProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestSetIssuanceQuotaProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestSetIssuanceQuotaProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestSetIssuanceQuotaProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestSetIssuanceQuotaProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestSetIssuanceQuotaProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) LoadOperation(fileName string,
+) *TestSetIssuanceQuotaProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) Print(fileName string,
+) *TestSetIssuanceQuotaProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) SetTemplate(
+	templateID string,
+) *TestSetIssuanceQuotaProcessor {
+	t.templateID = templateID
+
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) SetQuota(
+	maxCredentials, maxIssuances, period uint64,
+) *TestSetIssuanceQuotaProcessor {
+	t.quota = types.NewQuota(maxCredentials, maxIssuances, period)
+
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestSetIssuanceQuotaProcessor {
+	op := NewSetIssuanceQuota(
+		NewSetIssuanceQuotaFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.templateID,
+			t.quota,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) RunPreProcess() *TestSetIssuanceQuotaProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) RunProcess() *TestSetIssuanceQuotaProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) IsValid() *TestSetIssuanceQuotaProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestSetIssuanceQuotaProcessor) Decode(fileName string) *TestSetIssuanceQuotaProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
@@ -0,0 +1,162 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestShareTemplateProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[ShareTemplate]
+	templateID string
+	grantees   []base.Address
+}
+
+func NewTestShareTemplateProcessor(tp *test.TestProcessor) TestShareTemplateProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[ShareTemplate](tp)
+	return TestShareTemplateProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestShareTemplateProcessor) Create() *TestShareTemplateProcessor {
+	t.Opr, _ = NewShareTemplateProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestShareTemplateProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestShareTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestShareTemplateProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestShareTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestShareTemplateProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestShareTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestShareTemplateProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestShareTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestShareTemplateProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestShareTemplateProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestShareTemplateProcessor) LoadOperation(fileName string,
+) *TestShareTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestShareTemplateProcessor) Print(fileName string,
+) *TestShareTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestShareTemplateProcessor) SetTemplate(
+	templateID string,
+	grantees []test.Account,
+) *TestShareTemplateProcessor {
+	t.templateID = templateID
+	t.grantees = make([]base.Address, len(grantees))
+	for i := range grantees {
+		t.grantees[i] = grantees[i].Address()
+	}
+
+	return t
+}
+
+func (t *TestShareTemplateProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestShareTemplateProcessor {
+	op := NewShareTemplate(
+		NewShareTemplateFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.templateID,
+			t.grantees,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestShareTemplateProcessor) RunPreProcess() *TestShareTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestShareTemplateProcessor) RunProcess() *TestShareTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestShareTemplateProcessor) IsValid() *TestShareTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestShareTemplateProcessor) Decode(fileName string) *TestShareTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
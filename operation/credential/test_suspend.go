@@ -0,0 +1,169 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	credentialtypes "github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestSuspendProcessor struct {
+	*test.BaseTestOperationProcessorWithItem[Suspend, SuspendItem]
+	templateID string
+	id         string
+}
+
+func NewTestSuspendProcessor(tp *test.TestProcessor) TestSuspendProcessor {
+	t := test.NewBaseTestOperationProcessorWithItem[Suspend, SuspendItem](tp)
+	return TestSuspendProcessor{BaseTestOperationProcessorWithItem: &t}
+}
+
+func (t *TestSuspendProcessor) Create() *TestSuspendProcessor {
+	t.Opr, _ = NewSuspendProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestSuspendProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []types.CurrencyID, instate bool,
+) *TestSuspendProcessor {
+	t.BaseTestOperationProcessorWithItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestSuspendProcessor) SetAmount(
+	am int64, cid types.CurrencyID, target []types.Amount,
+) *TestSuspendProcessor {
+	t.BaseTestOperationProcessorWithItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestSuspendProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestSuspendProcessor {
+	t.BaseTestOperationProcessorWithItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestSuspendProcessor) SetAccount(
+	priv string, amount int64, cid types.CurrencyID, target []test.Account, inState bool,
+) *TestSuspendProcessor {
+	t.BaseTestOperationProcessorWithItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestSuspendProcessor) LoadOperation(fileName string,
+) *TestSuspendProcessor {
+	t.BaseTestOperationProcessorWithItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestSuspendProcessor) Print(fileName string,
+) *TestSuspendProcessor {
+	t.BaseTestOperationProcessorWithItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestSuspendProcessor) SetTemplate(
+	templateID,
+	id string,
+) *TestSuspendProcessor {
+	t.templateID = templateID
+	t.id = id
+
+	return t
+}
+
+func (t *TestSuspendProcessor) SetService(
+	contract base.Address,
+) *TestSuspendProcessor {
+	var templates []string
+
+	policy := credentialtypes.NewPolicy(templates, 0, 0)
+	design := credentialtypes.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestSuspendProcessor) MakeItem(
+	contract, holder test.Account, currency types.CurrencyID, targetItems []SuspendItem,
+) *TestSuspendProcessor {
+	item := NewSuspendItem(
+		contract.Address(),
+		holder.Address(),
+		t.templateID,
+		t.id,
+		currency,
+	)
+	test.UpdateSlice[SuspendItem](item, targetItems)
+
+	return t
+}
+
+func (t *TestSuspendProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, items []SuspendItem,
+) *TestSuspendProcessor {
+	op := NewSuspend(
+		NewSuspendFact(
+			[]byte("token"),
+			sender,
+			items,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestSuspendProcessor) RunPreProcess() *TestSuspendProcessor {
+	t.BaseTestOperationProcessorWithItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestSuspendProcessor) RunProcess() *TestSuspendProcessor {
+	t.BaseTestOperationProcessorWithItem.RunProcess()
+
+	return t
+}
+
+func (t *TestSuspendProcessor) IsValid() *TestSuspendProcessor {
+	t.BaseTestOperationProcessorWithItem.IsValid()
+
+	return t
+}
+
+func (t *TestSuspendProcessor) Decode(fileName string) *TestSuspendProcessor {
+	t.BaseTestOperationProcessorWithItem.Decode(fileName)
+
+	return t
+}
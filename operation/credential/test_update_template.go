@@ -0,0 +1,175 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type TestUpdateTemplateProcessor struct {
+	*test.BaseTestOperationProcessorNoItem[UpdateTemplate]
+	templateID       string
+	templateName     string
+	expirationDate   types.Date
+	expirationHeight base.Height
+	displayName      string
+	description      string
+}
+
+func NewTestUpdateTemplateProcessor(tp *test.TestProcessor) TestUpdateTemplateProcessor {
+	t := test.NewBaseTestOperationProcessorNoItem[UpdateTemplate](tp)
+	return TestUpdateTemplateProcessor{BaseTestOperationProcessorNoItem: &t}
+}
+
+func (t *TestUpdateTemplateProcessor) Create() *TestUpdateTemplateProcessor {
+	t.Opr, _ = NewUpdateTemplateProcessor()(
+		base.GenesisHeight,
+		t.GetStateFunc,
+		nil, nil,
+	)
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) SetCurrency(
+	cid string, am int64, addr base.Address, target []ctypes.CurrencyID, instate bool,
+) *TestUpdateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetCurrency(cid, am, addr, target, instate)
+
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) SetAmount(
+	am int64, cid ctypes.CurrencyID, target []ctypes.Amount,
+) *TestUpdateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAmount(am, cid, target)
+
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) SetContractAccount(
+	owner base.Address, priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestUpdateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetContractAccount(owner, priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) SetAccount(
+	priv string, amount int64, cid ctypes.CurrencyID, target []test.Account, inState bool,
+) *TestUpdateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.SetAccount(priv, amount, cid, target, inState)
+
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) SetService(
+	contract base.Address, template types.Template,
+) *TestUpdateTemplateProcessor {
+
+	policy := types.NewPolicy([]string{template.TemplateID()}, 0, 0)
+	design := types.NewDesign(policy)
+
+	st := common.NewBaseState(base.Height(1), state.StateKeyDesign(contract), state.NewDesignStateValue(design), nil, []util.Hash{})
+	t.SetState(st, true)
+
+	tst := common.NewBaseState(base.Height(1), state.StateKeyTemplate(contract, template.TemplateID()), state.NewTemplateStateValue(template), nil, []util.Hash{})
+	t.SetState(tst, true)
+
+	cst, found, _ := t.MockGetter.Get(extension.StateKeyContractAccount(contract))
+	if !found {
+		panic("contract account not set")
+	}
+	status, err := extension.StateContractAccountValue(cst)
+	if err != nil {
+		panic(err)
+	}
+
+	nstatus := status.SetIsActive(true)
+	cState := common.NewBaseState(base.Height(1), extension.StateKeyContractAccount(contract), extension.NewContractAccountStateValue(nstatus), nil, []util.Hash{})
+	t.SetState(cState, true)
+
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) LoadOperation(fileName string,
+) *TestUpdateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.LoadOperation(fileName)
+
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) Print(fileName string,
+) *TestUpdateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.Print(fileName)
+
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) SetTemplate(
+	templateID, templateName string, expirationDate types.Date, displayName, description string,
+) *TestUpdateTemplateProcessor {
+	t.templateID = templateID
+	t.templateName = templateName
+	t.expirationDate = expirationDate
+	t.displayName = displayName
+	t.description = description
+
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) SetExpirationHeight(expirationHeight base.Height) *TestUpdateTemplateProcessor {
+	t.expirationHeight = expirationHeight
+
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) MakeOperation(
+	sender base.Address, privatekey base.Privatekey, contract base.Address, currency ctypes.CurrencyID,
+) *TestUpdateTemplateProcessor {
+	op := NewUpdateTemplate(
+		NewUpdateTemplateFact(
+			[]byte("token"),
+			sender,
+			contract,
+			t.templateID,
+			t.templateName,
+			t.expirationDate,
+			t.expirationHeight,
+			t.displayName,
+			t.description,
+			currency,
+		))
+	_ = op.Sign(privatekey, t.NetworkID)
+	t.Op = op
+
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) RunPreProcess() *TestUpdateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.RunPreProcess()
+
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) RunProcess() *TestUpdateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.RunProcess()
+
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) IsValid() *TestUpdateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.IsValid()
+
+	return t
+}
+
+func (t *TestUpdateTemplateProcessor) Decode(fileName string) *TestUpdateTemplateProcessor {
+	t.BaseTestOperationProcessorNoItem.Decode(fileName)
+
+	return t
+}
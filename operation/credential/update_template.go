@@ -0,0 +1,198 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	UpdateTemplateFactHint = hint.MustNewHint("mitum-credential-update-template-operation-fact-v0.0.1")
+	UpdateTemplateHint     = hint.MustNewHint("mitum-credential-update-template-operation-v0.0.1")
+)
+
+// UpdateTemplateFact replaces the mutable fields of a registered template;
+// template name, expiration date, expiration height, display name and
+// description.
+type UpdateTemplateFact struct {
+	base.BaseFact
+	sender           base.Address
+	contract         base.Address
+	templateID       string
+	templateName     string
+	expirationDate   types.Date
+	expirationHeight base.Height
+	displayName      string
+	description      string
+	currency         crcytypes.CurrencyID
+}
+
+func NewUpdateTemplateFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	templateName string,
+	expirationDate types.Date,
+	expirationHeight base.Height,
+	displayName string,
+	description string,
+	currency crcytypes.CurrencyID,
+) UpdateTemplateFact {
+	bf := base.NewBaseFact(UpdateTemplateFactHint, token)
+	fact := UpdateTemplateFact{
+		BaseFact:         bf,
+		sender:           sender,
+		contract:         contract,
+		templateID:       templateID,
+		templateName:     templateName,
+		expirationDate:   expirationDate,
+		expirationHeight: expirationHeight,
+		displayName:      displayName,
+		description:      description,
+		currency:         currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateTemplateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateTemplateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateTemplateFact) Bytes() []byte {
+	var hb []byte
+	if fact.expirationHeight > 0 {
+		hb = fact.expirationHeight.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		[]byte(fact.templateName),
+		fact.expirationDate.Bytes(),
+		hb,
+		[]byte(fact.displayName),
+		[]byte(fact.description),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateTemplateFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.expirationDate,
+		fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > types.MaxLengthTemplateID {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d, but %d", types.MaxLengthTemplateID, l)))
+	}
+
+	if !crcytypes.ReValidSpcecialCh.Match([]byte(fact.templateID)) {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", fact.TemplateID())))
+	}
+
+	if l := utf8.RuneCountInString(fact.templateName); l < 1 || l > types.MaxLengthTemplateName {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template name <= %d, but %d", types.MaxLengthTemplateName, l)))
+	}
+
+	if l := utf8.RuneCountInString(fact.displayName); l < 1 || l > types.MaxLengthDisplayName {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of display name <= %d, but %d", types.MaxLengthDisplayName, l)))
+	}
+
+	if l := utf8.RuneCountInString(fact.description); l < 1 || l > types.MaxLengthDescription {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("0 <= length of description <= %d, but %d", types.MaxLengthDescription, l)))
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if _, err := fact.expirationDate.Parse(); err != nil {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(err))
+	}
+
+	if fact.expirationHeight < 0 {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("negative expiration height, %v", fact.expirationHeight)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UpdateTemplateFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateTemplateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateTemplateFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateTemplateFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact UpdateTemplateFact) TemplateName() string {
+	return fact.templateName
+}
+
+func (fact UpdateTemplateFact) ExpirationDate() types.Date {
+	return fact.expirationDate
+}
+
+func (fact UpdateTemplateFact) ExpirationHeight() base.Height {
+	return fact.expirationHeight
+}
+
+func (fact UpdateTemplateFact) DisplayName() string {
+	return fact.displayName
+}
+
+func (fact UpdateTemplateFact) Description() string {
+	return fact.description
+}
+
+func (fact UpdateTemplateFact) Currency() crcytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateTemplateFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type UpdateTemplate struct {
+	common.BaseOperation
+}
+
+func NewUpdateTemplate(fact UpdateTemplateFact) UpdateTemplate {
+	return UpdateTemplate{BaseOperation: common.NewBaseOperation(UpdateTemplateHint, fact)}
+}
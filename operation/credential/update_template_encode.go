@@ -0,0 +1,39 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *UpdateTemplateFact) unpack(enc encoder.Encoder,
+	sAdr, cAdr, tmplID string,
+	tmplName, expDate string,
+	expHeight base.Height,
+	dpName, desc, cid string,
+) error {
+	fact.templateID = tmplID
+	fact.templateName = tmplName
+	fact.expirationDate = types.Date(expDate)
+	fact.expirationHeight = expHeight
+	fact.displayName = dpName
+	fact.description = desc
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = a
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type UpdateTemplateFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner            base.Address             `json:"sender"`
+	Contract         base.Address             `json:"contract"`
+	TemplateID       string                   `json:"template_id"`
+	TemplateName     string                   `json:"template_name"`
+	ExpirationDate   types.Date               `json:"expiration_date"`
+	ExpirationHeight base.Height              `json:"expiration_height,omitempty"`
+	DisplayName      string                   `json:"display_name"`
+	Description      string                   `json:"description"`
+	Currency         currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact UpdateTemplateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateTemplateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		TemplateName:          fact.templateName,
+		ExpirationDate:        fact.expirationDate,
+		ExpirationHeight:      fact.expirationHeight,
+		DisplayName:           fact.displayName,
+		Description:           fact.description,
+		Currency:              fact.currency,
+	})
+}
+
+type UpdateTemplateFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner            string      `json:"sender"`
+	Contract         string      `json:"contract"`
+	TemplateID       string      `json:"template_id"`
+	TemplateName     string      `json:"template_name"`
+	ExpirationDate   string      `json:"expiration_date"`
+	ExpirationHeight base.Height `json:"expiration_height"`
+	DisplayName      string      `json:"display_name"`
+	Description      string      `json:"description"`
+	Currency         string      `json:"currency"`
+}
+
+func (fact *UpdateTemplateFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf UpdateTemplateFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.TemplateID,
+		uf.TemplateName,
+		uf.ExpirationDate,
+		uf.ExpirationHeight,
+		uf.DisplayName,
+		uf.Description,
+		uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+type UpdateTemplateMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op UpdateTemplate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateTemplateMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateTemplate) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,1292 @@
+package credential
+
+import (
+	"slices"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	cstate "github.com/ProtoconNet/mitum-currency/v3/state"
+	statec "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	stetee "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	ctypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// checkIssuedCredential checks that sender has role on the template of
+// contract and that the credential of templateID and credentialID was issued to
+// holder. It returns the current credential state value.
+func checkIssuedCredential(
+	sender, contract, holder base.Address,
+	templateID, credentialID string,
+	role types.Role,
+	currency ctypes.CurrencyID,
+	getStateFunc base.GetStateFunc,
+) (state.CredentialStateValue, error) {
+	if err := cstate.CheckExistsState(statec.DesignStateKey(currency), getStateFunc); err != nil {
+		return state.CredentialStateValue{}, common.ErrCurrencyNF.Wrap(errors.Errorf("currency id %v", currency))
+	}
+
+	if _, _, aErr, cErr := cstate.ExistsCAccount(holder, "holder", true, false, getStateFunc); aErr != nil {
+		return state.CredentialStateValue{}, aErr
+	} else if cErr != nil {
+		return state.CredentialStateValue{}, common.ErrCAccountNA.Wrap(cErr)
+	}
+
+	_, cSt, aErr, cErr := cstate.ExistsCAccount(contract, "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return state.CredentialStateValue{}, aErr
+	} else if cErr != nil {
+		return state.CredentialStateValue{}, cErr
+	}
+
+	if err := checkTemplateRole(cSt, sender, contract, templateID, role, getStateFunc); err != nil {
+		return state.CredentialStateValue{}, err
+	}
+
+	cv, err := existsCredential(contract, templateID, credentialID, getStateFunc)
+	if err != nil {
+		return state.CredentialStateValue{}, err
+	}
+
+	if _, err := credentialTemplate(contract, cv.Credential, getStateFunc); err != nil {
+		return state.CredentialStateValue{}, err
+	}
+
+	if !cv.Credential.Holder().Equal(holder) {
+		return state.CredentialStateValue{}, common.ErrValueInvalid.Errorf(
+			"holder %v has not owned credential %v for template %v in contract account %v",
+			holder, credentialID, templateID, contract)
+	}
+
+	return cv, nil
+}
+
+// checkTemplateRole checks that sender is permitted to act as role on the
+// template of contract; cSt is the contract account state of contract. The
+// owner of contract account has every role. The handlers of contract account
+// are permitted together with the accounts assigned role, until the role-based
+// access of the template is enabled by GrantRole with restrict; after that,
+// only the accounts assigned role are.
+func checkTemplateRole(
+	cSt base.State,
+	sender, contract base.Address,
+	templateID string,
+	role types.Role,
+	getStateFunc base.GetStateFunc,
+) error {
+	ca, err := stetee.LoadCAStateValue(cSt)
+	if err != nil {
+		return err
+	}
+
+	if ca.Owner().Equal(sender) {
+		return nil
+	}
+
+	tr, err := templateRoles(contract, templateID, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	for i := range tr.Assignments {
+		if tr.Assignments[i].Account().Equal(sender) && tr.Assignments[i].HasRole(role) {
+			return nil
+		}
+	}
+
+	if !tr.Restricted {
+		_, err := stetee.CheckCAAuthFromState(cSt, sender)
+
+		return err
+	}
+
+	return common.ErrAccountNAth.Errorf(
+		"sender %v has no %v role on template %v in contract account %v", sender, role, templateID, contract)
+}
+
+// templateRoles returns the role assignments of the template; empty if no
+// role is assigned.
+func templateRoles(
+	contract base.Address, templateID string, getStateFunc base.GetStateFunc,
+) (state.TemplateRolesStateValue, error) {
+	switch st, found, err := getStateFunc(state.StateKeyTemplateRoles(contract, templateID)); {
+	case err != nil:
+		return state.TemplateRolesStateValue{}, common.ErrStateNF.Errorf(
+			"template roles of %v in contract account %v", templateID, contract)
+	case !found:
+		return state.NewTemplateRolesStateValue(nil), nil
+	default:
+		tr, err := state.StateTemplateRolesStateValue(st)
+		if err != nil {
+			return state.TemplateRolesStateValue{}, common.ErrStateValInvalid.Errorf(
+				"template roles of %v in contract account %v", templateID, contract)
+		}
+
+		return tr, nil
+	}
+}
+
+func checkRegisteredTemplate(contract base.Address, templateID string, getStateFunc base.GetStateFunc) error {
+	st, err := cstate.ExistsState(state.StateKeyDesign(contract), "design", getStateFunc)
+	if err != nil {
+		return common.ErrServiceNF.Errorf("credential design state for contract account %v", contract)
+	}
+
+	de, err := state.StateDesignValue(st)
+	if err != nil {
+		return common.ErrServiceNF.Errorf("credential design state value for contract account %v", contract)
+	}
+
+	if err := de.IsValid(nil); err != nil {
+		return err
+	}
+
+	for _, v := range de.Policy().TemplateIDs() {
+		if templateID == v {
+			return nil
+		}
+	}
+
+	return common.ErrValueInvalid.Errorf("not registered template %v", templateID)
+}
+
+// serviceDesign returns the credential design of contract.
+func serviceDesign(contract base.Address, getStateFunc base.GetStateFunc) (types.Design, error) {
+	st, err := cstate.ExistsState(state.StateKeyDesign(contract), "design", getStateFunc)
+	if err != nil {
+		return types.Design{}, common.ErrServiceNF.Errorf("credential design state for contract account %v", contract)
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return types.Design{}, common.ErrServiceNF.Errorf(
+			"credential design state value for contract account %v", contract)
+	}
+
+	return design, nil
+}
+
+// checkServiceNotClosed checks that the credential service of contract is not
+// closed.
+func checkServiceNotClosed(contract base.Address, getStateFunc base.GetStateFunc) error {
+	design, err := serviceDesign(contract, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if design.Closed() {
+		return common.ErrValueInvalid.Errorf(
+			"credential service of contract account %v closed at height %v", contract, design.ClosedAt())
+	}
+
+	return nil
+}
+
+// checkServiceOpen checks that the credential service of contract is neither
+// closed nor paused.
+func checkServiceOpen(contract base.Address, getStateFunc base.GetStateFunc) error {
+	design, err := serviceDesign(contract, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if design.Closed() {
+		return common.ErrValueInvalid.Errorf(
+			"credential service of contract account %v closed at height %v", contract, design.ClosedAt())
+	}
+
+	if design.Paused() {
+		return common.ErrValueInvalid.Errorf(
+			"credential service of contract account %v paused at height %v", contract, design.PausedAt())
+	}
+
+	return nil
+}
+
+// checkServiceTemplate checks that sender has the template admin role on the
+// template of contract and returns the template of templateID.
+func checkServiceTemplate(
+	sender, contract base.Address,
+	templateID string,
+	getStateFunc base.GetStateFunc,
+) (types.Template, error) {
+	_, cSt, aErr, cErr := cstate.ExistsCAccount(contract, "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return types.Template{}, aErr
+	} else if cErr != nil {
+		return types.Template{}, cErr
+	}
+
+	if err := checkTemplateRole(cSt, sender, contract, templateID, types.RoleTemplateAdmin, getStateFunc); err != nil {
+		return types.Template{}, err
+	}
+
+	if err := checkRegisteredTemplate(contract, templateID, getStateFunc); err != nil {
+		return types.Template{}, err
+	}
+
+	return existsTemplate(contract, templateID, getStateFunc)
+}
+
+// checkSharedTemplate checks that the template of templateID is shared by
+// templateContract and granted to contract, and returns the template.
+func checkSharedTemplate(
+	contract, templateContract base.Address,
+	templateID string,
+	getStateFunc base.GetStateFunc,
+) (types.Template, error) {
+	if _, _, aErr, cErr := cstate.ExistsCAccount(
+		templateContract, "template contract", true, true, getStateFunc); aErr != nil {
+		return types.Template{}, aErr
+	} else if cErr != nil {
+		return types.Template{}, cErr
+	}
+
+	if err := checkRegisteredTemplate(templateContract, templateID, getStateFunc); err != nil {
+		return types.Template{}, err
+	}
+
+	template, err := existsTemplate(templateContract, templateID, getStateFunc)
+	if err != nil {
+		return types.Template{}, err
+	}
+
+	if !template.TemplateShare() {
+		return types.Template{}, common.ErrValueInvalid.Errorf(
+			"template %v in contract account %v is not shared", templateID, templateContract)
+	}
+
+	if !template.IsGrantee(contract) {
+		return types.Template{}, common.ErrAccountNAth.Errorf(
+			"contract account %v is not granted template %v of contract account %v",
+			contract, templateID, templateContract)
+	}
+
+	return template, nil
+}
+
+// credentialTemplate returns the template of credential issued by contract.
+// The credential of shared template refers to the template in the contract
+// account which published it.
+func credentialTemplate(
+	contract base.Address, credential types.Credential, getStateFunc base.GetStateFunc,
+) (types.Template, error) {
+	if tc := credential.TemplateContract(); tc != nil {
+		contract = tc
+	}
+
+	if err := checkRegisteredTemplate(contract, credential.TemplateID(), getStateFunc); err != nil {
+		return types.Template{}, err
+	}
+
+	return existsTemplate(contract, credential.TemplateID(), getStateFunc)
+}
+
+func existsTemplate(contract base.Address, templateID string, getStateFunc base.GetStateFunc) (types.Template, error) {
+	st, err := cstate.ExistsState(state.StateKeyTemplate(contract, templateID), "template", getStateFunc)
+	if err != nil {
+		return types.Template{}, common.ErrStateNF.Errorf("template %v in contract account %v", templateID, contract)
+	}
+
+	template, err := state.StateTemplateValue(st)
+	if err != nil {
+		return types.Template{}, common.ErrStateValInvalid.Errorf("template %v in contract account %v", templateID, contract)
+	}
+
+	return template, nil
+}
+
+func existsCredential(
+	contract base.Address, templateID, credentialID string, getStateFunc base.GetStateFunc,
+) (state.CredentialStateValue, error) {
+	st, err := cstate.ExistsState(
+		state.StateKeyCredential(contract, templateID, credentialID), "credential", getStateFunc)
+	if err != nil {
+		return state.CredentialStateValue{}, common.ErrStateNF.Errorf(
+			"credential %v for template %v in contract account %v", credentialID, templateID, contract)
+	}
+
+	cv, err := state.StateCredentialStateValue(st)
+	if err != nil {
+		return state.CredentialStateValue{}, common.ErrStateValInvalid.Errorf(
+			"credential %v for template %v in contract account %v", credentialID, templateID, contract)
+	}
+
+	return cv, nil
+}
+
+// sameAddress compares the optional addresses; nil is only same with nil.
+func sameAddress(a, b base.Address) bool {
+	switch {
+	case a == nil || b == nil:
+		return a == nil && b == nil
+	default:
+		return a.Equal(b)
+	}
+}
+
+// checkRoleManager checks that sender can assign or withdraw roles on the
+// template of contract and returns the current role assignments. The owner of
+// contract account manages every role and enables the role-based access of
+// template by restrict; the template admin manages the issuer and revoker roles
+// of the template.
+func checkRoleManager(
+	sender, contract base.Address,
+	templateID string,
+	roles []types.Role,
+	restrict types.Bool,
+	getStateFunc base.GetStateFunc,
+) ([]types.RoleAssignment, error) {
+	_, cSt, aErr, cErr := cstate.ExistsCAccount(contract, "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return nil, aErr
+	} else if cErr != nil {
+		return nil, cErr
+	}
+
+	if err := checkRegisteredTemplate(contract, templateID, getStateFunc); err != nil {
+		return nil, err
+	}
+
+	ca, err := stetee.LoadCAStateValue(cSt)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := templateRoles(contract, templateID, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+	assignments := tr.Assignments
+
+	if ca.Owner().Equal(sender) {
+		return assignments, nil
+	}
+
+	if restrict {
+		return nil, common.ErrAccountNAth.Errorf(
+			"only owner of contract account %v restricts template %v to role-based access", contract, templateID)
+	}
+
+	for i := range roles {
+		if roles[i] == types.RoleTemplateAdmin {
+			return nil, common.ErrAccountNAth.Errorf(
+				"only owner of contract account %v manages %v role", contract, types.RoleTemplateAdmin)
+		}
+	}
+
+	for i := range assignments {
+		if assignments[i].Account().Equal(sender) && assignments[i].HasRole(types.RoleTemplateAdmin) {
+			return assignments, nil
+		}
+	}
+
+	return nil, common.ErrAccountNAth.Errorf(
+		"sender %v is neither owner of contract account %v nor %v of template %v",
+		sender, contract, types.RoleTemplateAdmin, templateID)
+}
+
+// grantRoles returns the role assignments with roles added to account.
+func grantRoles(assignments []types.RoleAssignment, account base.Address, roles []types.Role) ([]types.RoleAssignment, error) {
+	nassignments := make([]types.RoleAssignment, 0, len(assignments)+1)
+
+	var found bool
+	for i := range assignments {
+		if !assignments[i].Account().Equal(account) {
+			nassignments = append(nassignments, assignments[i])
+
+			continue
+		}
+
+		found = true
+
+		nroles := append([]types.Role{}, assignments[i].Roles()...)
+		for j := range roles {
+			if assignments[i].HasRole(roles[j]) {
+				return nil, common.ErrValueInvalid.Errorf("account %v already has %v role", account, roles[j])
+			}
+
+			nroles = append(nroles, roles[j])
+		}
+
+		nassignments = append(nassignments, types.NewRoleAssignment(account, nroles))
+	}
+
+	if !found {
+		if len(assignments) >= types.MaxTemplateRoleAssignments {
+			return nil, common.ErrArrayLen.Errorf(
+				"role assignments over max, %d", types.MaxTemplateRoleAssignments)
+		}
+
+		nassignments = append(nassignments, types.NewRoleAssignment(account, roles))
+	}
+
+	return nassignments, nil
+}
+
+// revokeRoles returns the role assignments with roles removed from account;
+// the account without roles is removed.
+func revokeRoles(assignments []types.RoleAssignment, account base.Address, roles []types.Role) ([]types.RoleAssignment, error) {
+	nassignments := make([]types.RoleAssignment, 0, len(assignments))
+
+	var found bool
+	for i := range assignments {
+		if !assignments[i].Account().Equal(account) {
+			nassignments = append(nassignments, assignments[i])
+
+			continue
+		}
+
+		found = true
+
+		for j := range roles {
+			if !assignments[i].HasRole(roles[j]) {
+				return nil, common.ErrValueInvalid.Errorf("account %v has no %v role", account, roles[j])
+			}
+		}
+
+		var nroles []types.Role
+		for _, r := range assignments[i].Roles() {
+			if !slices.Contains(roles, r) {
+				nroles = append(nroles, r)
+			}
+		}
+
+		if len(nroles) > 0 {
+			nassignments = append(nassignments, types.NewRoleAssignment(account, nroles))
+		}
+	}
+
+	if !found {
+		return nil, common.ErrValueInvalid.Errorf("account %v has no role", account)
+	}
+
+	return nassignments, nil
+}
+
+// checkOpenOffer checks that the credential offer of credentialID is open to
+// holder at height and returns the offer.
+func checkOpenOffer(
+	holder, contract base.Address,
+	templateID, credentialID string,
+	height base.Height,
+	getStateFunc base.GetStateFunc,
+) (state.OfferStateValue, error) {
+	st, err := cstate.ExistsState(
+		state.StateKeyOffer(contract, templateID, credentialID), "credential offer", getStateFunc)
+	if err != nil {
+		return state.OfferStateValue{}, common.ErrStateNF.Errorf(
+			"credential offer %v for template %v in contract account %v", credentialID, templateID, contract)
+	}
+
+	offer, err := state.StateOfferValue(st)
+	if err != nil {
+		return state.OfferStateValue{}, common.ErrStateValInvalid.Errorf(
+			"credential offer %v for template %v in contract account %v", credentialID, templateID, contract)
+	}
+
+	if !offer.Credential.Holder().Equal(holder) {
+		return state.OfferStateValue{}, common.ErrAccountNAth.Errorf(
+			"credential offer %v for template %v is not for %v", credentialID, templateID, holder)
+	}
+
+	if !offer.IsOpen(height) {
+		return state.OfferStateValue{}, common.ErrValueInvalid.Errorf(
+			"credential offer %v for template %v in contract account %v is %v",
+			credentialID, templateID, contract, offer.CurrentStatus(height))
+	}
+
+	return offer, nil
+}
+
+// checkCredentialIssuable checks that the credential of credentialID is not
+// issued yet, or is terminated under the same template of templateContract.
+func checkCredentialIssuable(
+	contract, templateContract base.Address,
+	templateID, credentialID string,
+	getStateFunc base.GetStateFunc,
+) error {
+	switch st, found, err := getStateFunc(state.StateKeyCredential(contract, templateID, credentialID)); {
+	case err != nil:
+		return common.ErrStateNF.Errorf(
+			"credential %v for template id %v in contract account %v", credentialID, templateID, contract)
+	case !found:
+	default:
+		if credential, status, err := state.StateCredentialValue(st); err != nil {
+			return common.ErrStateValInvalid.Errorf(
+				"credential %v for template id %v in contract account %v", credentialID, templateID, contract)
+		} else if !status.IsTerminated() {
+			return common.ErrValueInvalid.Errorf(
+				"credential %v for template %v is already issued to holder %v in contract account %v",
+				credentialID, templateID, credential.Holder(), contract)
+		} else if !sameAddress(credential.TemplateContract(), templateContract) {
+			return common.ErrValueInvalid.Errorf(
+				"%v credential %v for template %v in contract account %v was issued under template of %v",
+				status, credentialID, templateID, contract, credential.TemplateContract())
+		}
+	}
+
+	return nil
+}
+
+// checkNoOpenOffer checks that the credential of credentialID is not offered
+// to any holder at height; the open offer reserves the credential id until the
+// holder accepts or declines it, or it expires.
+func checkNoOpenOffer(
+	contract base.Address,
+	templateID, credentialID string,
+	height base.Height,
+	getStateFunc base.GetStateFunc,
+) error {
+	switch st, found, err := getStateFunc(state.StateKeyOffer(contract, templateID, credentialID)); {
+	case err != nil:
+		return common.ErrStateNF.Errorf(
+			"credential offer %v for template %v in contract account %v", credentialID, templateID, contract)
+	case !found:
+	default:
+		if offer, err := state.StateOfferValue(st); err != nil {
+			return common.ErrStateValInvalid.Errorf(
+				"credential offer %v for template %v in contract account %v", credentialID, templateID, contract)
+		} else if offer.IsOpen(height) {
+			return common.ErrValueInvalid.Errorf(
+				"credential %v for template %v is already offered to holder %v in contract account %v",
+				credentialID, templateID, offer.Credential.Holder(), contract)
+		}
+	}
+
+	return nil
+}
+
+// checkPendingRequest returns the pending credential request of requestID for
+// the template of templateID.
+func checkPendingRequest(
+	contract base.Address,
+	templateID, requestID string,
+	getStateFunc base.GetStateFunc,
+) (state.RequestStateValue, error) {
+	st, err := cstate.ExistsState(state.StateKeyRequest(contract, requestID), "credential request", getStateFunc)
+	if err != nil {
+		return state.RequestStateValue{}, common.ErrStateNF.Errorf(
+			"credential request %v in contract account %v", requestID, contract)
+	}
+
+	request, err := state.StateRequestValue(st)
+	if err != nil {
+		return state.RequestStateValue{}, common.ErrStateValInvalid.Errorf(
+			"credential request %v in contract account %v", requestID, contract)
+	}
+
+	if request.TemplateID != templateID {
+		return state.RequestStateValue{}, common.ErrValueInvalid.Errorf(
+			"credential request %v in contract account %v is for template %v, not %v",
+			requestID, contract, request.TemplateID, templateID)
+	}
+
+	if request.Status != types.RequestStatusPending {
+		return state.RequestStateValue{}, common.ErrValueInvalid.Errorf(
+			"credential request %v in contract account %v is %v", requestID, contract, request.Status)
+	}
+
+	return request, nil
+}
+
+// checkRenounceable checks that holder owns the credential of credentialID
+// which is not revoked or renounced yet, and returns the credential.
+func checkRenounceable(
+	holder, contract base.Address,
+	templateID, credentialID string,
+	getStateFunc base.GetStateFunc,
+) (state.CredentialStateValue, error) {
+	cv, err := existsCredential(contract, templateID, credentialID, getStateFunc)
+	if err != nil {
+		return state.CredentialStateValue{}, err
+	}
+
+	if !cv.Credential.Holder().Equal(holder) {
+		return state.CredentialStateValue{}, common.ErrAccountNAth.Errorf(
+			"holder %v has not owned credential %v for template %v in contract account %v",
+			holder, credentialID, templateID, contract)
+	}
+
+	if cv.Status.IsTerminated() {
+		return state.CredentialStateValue{}, common.ErrValueInvalid.Errorf(
+			"already %v credential %v for template %v in contract account %v",
+			cv.Status, credentialID, templateID, contract)
+	}
+
+	return cv, nil
+}
+
+// checkAmendable checks that sender has the issuer role on the template of
+// contract and that value can replace the value of the active credential of
+// credentialID. It returns the current credential state value.
+func checkAmendable(
+	sender, contract base.Address,
+	templateID, credentialID, value string,
+	getStateFunc base.GetStateFunc,
+) (state.CredentialStateValue, error) {
+	_, cSt, aErr, cErr := cstate.ExistsCAccount(contract, "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return state.CredentialStateValue{}, aErr
+	} else if cErr != nil {
+		return state.CredentialStateValue{}, cErr
+	}
+
+	if err := checkTemplateRole(cSt, sender, contract, templateID, types.RoleIssuer, getStateFunc); err != nil {
+		return state.CredentialStateValue{}, err
+	}
+
+	cv, err := existsCredential(contract, templateID, credentialID, getStateFunc)
+	if err != nil {
+		return state.CredentialStateValue{}, err
+	}
+
+	if cv.Status != types.CredentialStatusActive {
+		return state.CredentialStateValue{}, common.ErrValueInvalid.Errorf(
+			"only active credential can be amended, credential %v for template %v in contract account %v is %v",
+			credentialID, templateID, contract, cv.Status)
+	}
+
+	if cv.Credential.Value() == value {
+		return state.CredentialStateValue{}, common.ErrValueInvalid.Errorf(
+			"same value with credential %v for template %v in contract account %v",
+			credentialID, templateID, contract)
+	}
+
+	template, err := credentialTemplate(contract, cv.Credential, getStateFunc)
+	if err != nil {
+		return state.CredentialStateValue{}, err
+	}
+
+	if err := template.ValidateValue(value); err != nil {
+		return state.CredentialStateValue{}, errors.Errorf(
+			"credential value does not conform to schema of template %v; %v", templateID, err)
+	}
+
+	if template.UniqueValue() {
+		if err := checkUniqueValue(contract, cv.Credential.Holder(), templateID, value, getStateFunc); err != nil {
+			return state.CredentialStateValue{}, err
+		}
+	}
+
+	return cv, nil
+}
+
+// checkSupersedable checks that sender can revoke the credential of
+// credentialID to be superseded by the credential of it and returns the
+// credential to be superseded.
+func checkSupersedable(
+	sender base.Address, credentialID string, it IssueItem, getStateFunc base.GetStateFunc,
+) (state.CredentialStateValue, error) {
+	cv, err := checkIssuedCredential(
+		sender, it.Contract(), it.Holder(), it.TemplateID(), credentialID,
+		types.RoleRevoker, it.Currency(), getStateFunc)
+	if err != nil {
+		return state.CredentialStateValue{}, err
+	}
+
+	if cv.Status.IsTerminated() {
+		return state.CredentialStateValue{}, common.ErrValueInvalid.Errorf(
+			"already %v credential %v for template %v in contract account %v",
+			cv.Status, credentialID, it.TemplateID(), it.Contract())
+	}
+
+	if !sameAddress(cv.Credential.TemplateContract(), it.TemplateContract()) {
+		return state.CredentialStateValue{}, common.ErrValueInvalid.Errorf(
+			"superseded credential %v for template %v in contract account %v was issued under template of %v",
+			credentialID, it.TemplateID(), it.Contract(), cv.Credential.TemplateContract())
+	}
+
+	// NOTE the id of superseded credential is not reused by its successor so
+	// that the supersession links are not overwritten.
+	switch st, found, err := getStateFunc(state.StateKeyCredential(it.Contract(), it.TemplateID(), it.CredentialID())); {
+	case err != nil:
+		return state.CredentialStateValue{}, common.ErrStateNF.Errorf(
+			"credential %v for template id %v in contract account %v", it.CredentialID(), it.TemplateID(), it.Contract())
+	case !found:
+	default:
+		if ov, err := state.StateCredentialStateValue(st); err != nil {
+			return state.CredentialStateValue{}, common.ErrStateValInvalid.Errorf(
+				"credential %v for template id %v in contract account %v",
+				it.CredentialID(), it.TemplateID(), it.Contract())
+		} else if len(ov.SupersededBy) > 0 {
+			return state.CredentialStateValue{}, common.ErrValueInvalid.Errorf(
+				"credential %v for template %v in contract account %v is already superseded by %v",
+				it.CredentialID(), it.TemplateID(), it.Contract(), ov.SupersededBy)
+		}
+	}
+
+	return cv, nil
+}
+
+// checkRevocableCredentials checks that sender can revoke the credentials of
+// refs in contract.
+func checkRevocableCredentials(
+	sender, contract base.Address, refs []types.CredentialRef, getStateFunc base.GetStateFunc,
+) error {
+	_, cSt, aErr, cErr := cstate.ExistsCAccount(contract, "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return aErr
+	} else if cErr != nil {
+		return cErr
+	}
+
+	if len(refs) < 1 {
+		return common.ErrValueInvalid.Errorf("no credentials to revoke in contract account %v", contract)
+	}
+
+	checked := map[string]struct{}{}
+	for i := range refs {
+		templateID := refs[i].TemplateID()
+		if _, found := checked[templateID]; found {
+			continue
+		}
+
+		if err := checkTemplateRole(cSt, sender, contract, templateID, types.RoleRevoker, getStateFunc); err != nil {
+			return err
+		}
+
+		checked[templateID] = struct{}{}
+	}
+
+	return nil
+}
+
+// checkTemplateRevocation checks that sender can revoke the credentials of the
+// template of templateID in contract, and returns the revocation cursor from
+// which the revocation continues. The revocation not in progress starts from
+// the last credential page of the template.
+func checkTemplateRevocation(
+	sender, contract base.Address, templateID string, getStateFunc base.GetStateFunc,
+) (state.RevocationCursorStateValue, error) {
+	_, cSt, aErr, cErr := cstate.ExistsCAccount(contract, "contract", true, true, getStateFunc)
+	if aErr != nil {
+		return state.RevocationCursorStateValue{}, aErr
+	} else if cErr != nil {
+		return state.RevocationCursorStateValue{}, cErr
+	}
+
+	if err := checkTemplateRole(cSt, sender, contract, templateID, types.RoleRevoker, getStateFunc); err != nil {
+		return state.RevocationCursorStateValue{}, err
+	}
+
+	cursor, err := revocationCursor(state.StateKeyTemplateRevocationCursor(contract, templateID), getStateFunc)
+	if err != nil {
+		return state.RevocationCursorStateValue{}, err
+	}
+
+	if cursor.InProgress() {
+		return cursor, nil
+	}
+
+	last, err := lastCredentialPage(contract, templateID, getStateFunc)
+	if err != nil {
+		return state.RevocationCursorStateValue{}, err
+	}
+
+	if last == base.NilHeight {
+		return state.RevocationCursorStateValue{}, common.ErrValueInvalid.Errorf(
+			"no credentials to revoke for template %v in contract account %v", templateID, contract)
+	}
+
+	return state.NewRevocationCursorStateValue(templateID, last, 0), nil
+}
+
+// holderRevocation returns at most MaxRevokeAllCredentials references of the
+// credentials of holder in the credential service of stats to revoke; the
+// following operations revoke the rest. The credentials of holder issued by
+// the previous versions should be indexed by IndexCredentials first,
+// otherwise they are left active.
+func holderRevocation(
+	holder base.Address, stats *holderStats, getStateFunc base.GetStateFunc,
+) ([]types.CredentialRef, error) {
+	refs, err := credentialIndex(state.StateKeyHolderCredentials(stats.contract, holder), getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := stats.holder(holder, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if n := h.CredentialCount(); n > uint64(len(refs)) {
+		return nil, common.ErrValueInvalid.Errorf(
+			"%d credentials of holder %v of previous versions are not indexed in contract account %v; index them by IndexCredentials first",
+			n-uint64(len(refs)), holder, stats.contract)
+	}
+
+	if len(refs) > int(MaxRevokeAllCredentials) {
+		refs = refs[:MaxRevokeAllCredentials]
+	}
+
+	return refs, nil
+}
+
+// revokeCredentials revokes the credentials of refs in contract with
+// revocation and uncounts them in stats. The credentials revoked or renounced
+// already are skipped.
+func revokeCredentials(
+	contract base.Address,
+	refs []types.CredentialRef,
+	revocation types.Revocation,
+	stats *holderStats,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	var sts []base.StateMergeValue
+
+	for i := range refs {
+		// NOTE the credential may be revoked already by the cascade of the
+		// previous one.
+		if stats.isRemoved(refs[i]) {
+			continue
+		}
+
+		templateID, credentialID := refs[i].TemplateID(), refs[i].CredentialID()
+
+		cv, err := existsCredential(contract, templateID, credentialID, getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		// NOTE the credential pages keep the revoked and renounced
+		// credentials.
+		if cv.Status.IsTerminated() {
+			continue
+		}
+
+		if err := stats.remove(cv.Credential.Holder(), refs[i], getStateFunc); err != nil {
+			return nil, err
+		}
+
+		sts = append(sts, cstate.NewStateMergeValue(
+			state.StateKeyCredential(contract, templateID, credentialID),
+			cv.SetStatus(types.CredentialStatusRevoked).SetRevocation(revocation),
+		))
+
+		cSts, err := cascadeRevocation(contract, cv.Credential.Holder(), refs[i], revocation, stats, getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+		sts = append(sts, cSts...)
+	}
+
+	return sts, nil
+}
+
+// revocationCursor returns the revocation cursor of key; the cursor is not in
+// progress if not found.
+func revocationCursor(key string, getStateFunc base.GetStateFunc) (state.RevocationCursorStateValue, error) {
+	switch st, found, err := getStateFunc(key); {
+	case err != nil:
+		return state.RevocationCursorStateValue{}, common.ErrStateNF.Errorf("revocation cursor %v", key)
+	case !found:
+		return state.NewRevocationCursorStateValue("", base.NilHeight, 0), nil
+	default:
+		rc, err := state.StateRevocationCursorValue(st)
+		if err != nil {
+			return state.RevocationCursorStateValue{}, common.ErrStateValInvalid.Errorf("revocation cursor %v", key)
+		}
+
+		return rc, nil
+	}
+}
+
+// lastCredentialPage returns the height of the last credential page of the
+// template of templateID in contract; base.NilHeight if no credentials are
+// indexed.
+func lastCredentialPage(
+	contract base.Address, templateID string, getStateFunc base.GetStateFunc,
+) (base.Height, error) {
+	k := state.StateKeyTemplateCredentials(contract, templateID)
+
+	switch st, found, err := getStateFunc(k); {
+	case err != nil:
+		return base.NilHeight, common.ErrStateNF.Errorf("credential pages %v", k)
+	case !found:
+		return base.NilHeight, nil
+	default:
+		last, err := state.StateCredentialPagesValue(st)
+		if err != nil {
+			return base.NilHeight, common.ErrStateValInvalid.Errorf("credential pages %v", k)
+		}
+
+		return last, nil
+	}
+}
+
+// nextCredentials walks the credential pages of the template of cursor in
+// contract from cursor, and returns at most limit credential references and
+// the cursor of the next ones. The returned cursor is not in progress when
+// the pages are all walked.
+func nextCredentials(
+	contract base.Address,
+	cursor state.RevocationCursorStateValue,
+	limit int,
+	getStateFunc base.GetStateFunc,
+) ([]types.CredentialRef, state.RevocationCursorStateValue, error) {
+	var refs []types.CredentialRef
+
+	height, offset := cursor.Height, cursor.Offset
+	for height != base.NilHeight && len(refs) < limit {
+		page, err := credentialPage(contract, cursor.TemplateID, height, getStateFunc)
+		if err != nil {
+			return nil, state.RevocationCursorStateValue{}, err
+		}
+
+		if offset > uint64(len(page.Credentials)) {
+			return nil, state.RevocationCursorStateValue{}, common.ErrStateValInvalid.Errorf(
+				"revocation cursor of template %v in contract account %v; offset %d over %d credentials at height %v",
+				cursor.TemplateID, contract, offset, len(page.Credentials), height)
+		}
+
+		n := uint64(len(page.Credentials)) - offset
+		if r := uint64(limit - len(refs)); n > r {
+			n = r
+		}
+
+		refs = append(refs, page.Credentials[offset:offset+n]...)
+		offset += n
+
+		if offset == uint64(len(page.Credentials)) {
+			height, offset = page.Previous, 0
+		}
+	}
+
+	if height == base.NilHeight {
+		return refs, state.NewRevocationCursorStateValue(cursor.TemplateID, base.NilHeight, 0), nil
+	}
+
+	return refs, state.NewRevocationCursorStateValue(cursor.TemplateID, height, offset), nil
+}
+
+// nextServiceCredentials walks the credential pages of the templates of the
+// credential service of contract in the order of design from cursor, and
+// returns at most limit credential references and the cursor of the next
+// ones. The revocation of the credential service starts from the cursor not
+// in progress; the returned cursor is not in progress when the pages of the
+// templates are all walked. The credentials issued by contract with the
+// templates shared by the other contract accounts are not included.
+func nextServiceCredentials(
+	contract base.Address,
+	design types.Design,
+	cursor state.RevocationCursorStateValue,
+	limit int,
+	getStateFunc base.GetStateFunc,
+) ([]types.CredentialRef, state.RevocationCursorStateValue, error) {
+	templateIDs := design.Policy().TemplateIDs()
+
+	// NOTE next moves cursor to the last page of the next template with the
+	// indexed credentials.
+	next := func(templateID string) (state.RevocationCursorStateValue, error) {
+		i := -1
+		if len(templateID) > 0 {
+			if i = slices.Index(templateIDs, templateID); i < 0 {
+				return state.RevocationCursorStateValue{}, common.ErrValueInvalid.Errorf(
+					"template %v of revocation cursor not in contract account %v", templateID, contract)
+			}
+		}
+
+		for _, id := range templateIDs[i+1:] {
+			last, err := lastCredentialPage(contract, id, getStateFunc)
+			if err != nil {
+				return state.RevocationCursorStateValue{}, err
+			}
+
+			if last != base.NilHeight {
+				return state.NewRevocationCursorStateValue(id, last, 0), nil
+			}
+		}
+
+		return state.NewRevocationCursorStateValue("", base.NilHeight, 0), nil
+	}
+
+	if !cursor.InProgress() {
+		c, err := next("")
+		if err != nil {
+			return nil, state.RevocationCursorStateValue{}, err
+		}
+		cursor = c
+	}
+
+	var refs []types.CredentialRef
+
+	for cursor.InProgress() && len(refs) < limit {
+		trefs, c, err := nextCredentials(contract, cursor, limit-len(refs), getStateFunc)
+		if err != nil {
+			return nil, state.RevocationCursorStateValue{}, err
+		}
+
+		refs = append(refs, trefs...)
+
+		if !c.InProgress() {
+			if c, err = next(cursor.TemplateID); err != nil {
+				return nil, state.RevocationCursorStateValue{}, err
+			}
+		}
+
+		cursor = c
+	}
+
+	return refs, cursor, nil
+}
+
+// credentialPage returns the credential page of the template of templateID in
+// contract at height.
+func credentialPage(
+	contract base.Address, templateID string, height base.Height, getStateFunc base.GetStateFunc,
+) (state.CredentialPageStateValue, error) {
+	k := state.StateKeyTemplateCredentialPage(contract, templateID, height)
+
+	st, err := cstate.ExistsState(k, "credential page", getStateFunc)
+	if err != nil {
+		return state.CredentialPageStateValue{}, common.ErrStateNF.Errorf("credential page %v", k)
+	}
+
+	page, err := state.StateCredentialPageValue(st)
+	if err != nil {
+		return state.CredentialPageStateValue{}, common.ErrStateValInvalid.Errorf("credential page %v", k)
+	}
+
+	if page.Previous >= height {
+		return state.CredentialPageStateValue{}, common.ErrStateValInvalid.Errorf(
+			"credential page %v; previous page at height %v, not before %v", k, page.Previous, height)
+	}
+
+	return page, nil
+}
+
+// credentialIndex returns the credential references kept in the credential
+// index state of key; nil if not found.
+func credentialIndex(key string, getStateFunc base.GetStateFunc) ([]types.CredentialRef, error) {
+	switch st, found, err := getStateFunc(key); {
+	case err != nil:
+		return nil, common.ErrStateNF.Errorf("credential index %v", key)
+	case !found:
+		return nil, nil
+	default:
+		refs, err := state.StateCredentialIndexValue(st)
+		if err != nil {
+			return nil, common.ErrStateValInvalid.Errorf("credential index %v", key)
+		}
+
+		return refs, nil
+	}
+}
+
+// checkPrerequisites checks that holder has an active credential of each
+// prerequisite template of template in contract.
+//
+// NOTE operation processors have no access to the proposal time and the
+// validity period of credential is given by the issuer, so any time in it
+// could be backdated. The prerequisite credential should be active in the
+// state of the current height and its validity period should cover the whole
+// validity period of the credential to be issued; the credential issued with a
+// backdated period is expired together with its prerequisite.
+func checkPrerequisites(
+	contract, holder base.Address, template types.Template, validFrom, validUntil uint64,
+	getStateFunc base.GetStateFunc,
+) error {
+	if len(template.Prerequisites()) < 1 {
+		return nil
+	}
+
+	refs, err := credentialIndex(state.StateKeyHolderCredentials(contract, holder), getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	for _, templateID := range template.Prerequisites() {
+		var found bool
+
+		for i := range refs {
+			if refs[i].TemplateID() != templateID {
+				continue
+			}
+
+			cv, err := existsCredential(contract, templateID, refs[i].CredentialID(), getStateFunc)
+			if err != nil {
+				return err
+			}
+
+			c := cv.Credential
+			if cv.Status == types.CredentialStatusActive && c.Holder().Equal(holder) &&
+				c.ValidFrom() <= validFrom && validUntil <= c.ValidUntil() {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return common.ErrValueInvalid.Errorf(
+				"holder %v has no active credential of prerequisite template %v in contract account %v",
+				holder, templateID, contract)
+		}
+	}
+
+	return nil
+}
+
+// checkIssuanceLimits checks that the credential of value of template in
+// contract can be issued to holder within the limits of template, counting the
+// credentials issued by the previous items of the operation in batch. The
+// credential of superseded is not counted since it is revoked by its
+// successor.
+//
+// NOTE the credentials issued before the credential index was introduced are
+// not counted.
+func checkIssuanceLimits(
+	contract, holder base.Address,
+	template types.Template,
+	value, superseded string,
+	batch *issueBatch,
+	getStateFunc base.GetStateFunc,
+) error {
+	templateID := template.TemplateID()
+
+	if limit := template.MaxPerHolder(); limit > 0 {
+		refs, err := credentialIndex(state.StateKeyHolderCredentials(contract, holder), getStateFunc)
+		if err != nil {
+			return err
+		}
+
+		n := batch.count(contract, holder, templateID)
+		for i := range refs {
+			if refs[i].TemplateID() == templateID && refs[i].CredentialID() != superseded {
+				n++
+			}
+		}
+
+		if n >= limit {
+			return common.ErrValueInvalid.Errorf(
+				"holder %v already has %d credentials of template %v in contract account %v, max %d",
+				holder, n, templateID, contract, limit)
+		}
+	}
+
+	if !template.UniqueValue() {
+		return nil
+	}
+
+	if h := batch.holder(contract, templateID, value); h != nil && !h.Equal(holder) {
+		return common.ErrValueInvalid.Errorf(
+			"credential value of template %v is already issued to holder %v in contract account %v",
+			templateID, h, contract)
+	}
+
+	return checkUniqueValue(contract, holder, templateID, value, getStateFunc)
+}
+
+// checkUniqueValue checks that no holder but holder has the credential of
+// value of the template of templateID in contract, which is not revoked nor
+// renounced.
+func checkUniqueValue(
+	contract, holder base.Address, templateID, value string, getStateFunc base.GetStateFunc,
+) error {
+	refs, err := credentialIndex(state.StateKeyValueCredentials(contract, templateID, value), getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	for i := range refs {
+		cv, err := existsCredential(contract, refs[i].TemplateID(), refs[i].CredentialID(), getStateFunc)
+		if err != nil {
+			return err
+		}
+
+		c := cv.Credential
+		if cv.Status.IsTerminated() || c.Value() != value || c.Holder().Equal(holder) {
+			continue
+		}
+
+		return common.ErrValueInvalid.Errorf(
+			"credential value of template %v is already issued to holder %v in contract account %v",
+			templateID, c.Holder(), contract)
+	}
+
+	return nil
+}
+
+// cascadeRevocation revokes the credentials of holder which depend on the
+// revoked credential of ref in contract, that is, the credentials of the
+// templates declaring the template of ref as prerequisite with cascade. The
+// dependent credentials are kept while holder still has another active
+// credential of the prerequisite template. The revocation of the dependent
+// credential records ref as its cause and cascades again.
+//
+// NOTE the dependent credentials are revoked without the revoker role on
+// their templates; the templates opted in to cascade.
+func cascadeRevocation(
+	contract, holder base.Address,
+	ref types.CredentialRef,
+	revocation types.Revocation,
+	stats *holderStats,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	var sts []base.StateMergeValue
+
+	causes := []types.CredentialRef{ref}
+	for len(causes) > 0 {
+		cause := causes[0]
+		causes = causes[1:]
+
+		refs, err := stats.index(state.StateKeyHolderCredentials(contract, holder), getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		var kept bool
+		var dependents []types.CredentialRef
+		cvs := map[string]state.CredentialStateValue{}
+
+		for i := range refs {
+			cv, err := existsCredential(contract, refs[i].TemplateID(), refs[i].CredentialID(), getStateFunc)
+			if err != nil {
+				return nil, err
+			}
+
+			if refs[i].TemplateID() == cause.TemplateID() {
+				if cv.Status == types.CredentialStatusActive {
+					kept = true
+
+					break
+				}
+
+				continue
+			}
+
+			template, err := credentialTemplate(contract, cv.Credential, getStateFunc)
+			if err != nil {
+				return nil, err
+			}
+
+			if bool(template.Cascade()) && template.IsPrerequisite(cause.TemplateID()) {
+				dependents = append(dependents, refs[i])
+				cvs[refs[i].String()] = cv
+			}
+		}
+
+		if kept {
+			continue
+		}
+
+		for i := range dependents {
+			if err := stats.remove(holder, dependents[i], getStateFunc); err != nil {
+				return nil, err
+			}
+
+			sts = append(sts, cstate.NewStateMergeValue(
+				state.StateKeyCredential(contract, dependents[i].TemplateID(), dependents[i].CredentialID()),
+				cvs[dependents[i].String()].SetStatus(types.CredentialStatusRevoked).SetRevocation(
+					revocation.SetCascadedFrom(cause)),
+			))
+
+			causes = append(causes, dependents[i])
+		}
+	}
+
+	return sts, nil
+}
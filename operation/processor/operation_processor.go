@@ -3,6 +3,8 @@ package processor
 import (
 	"fmt"
 	"github.com/ProtoconNet/mitum-credential/operation/credential"
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
 	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/operation/extension"
 	currencyprocessor "github.com/ProtoconNet/mitum-currency/v3/operation/processor"
@@ -16,8 +18,58 @@ const (
 	DuplicationTypeCurrency   currencytypes.DuplicationType = "currency"
 	DuplicationTypeContract   currencytypes.DuplicationType = "contract"
 	DuplicationTypeCredential currencytypes.DuplicationType = "credential"
+	DuplicationTypeTemplate   currencytypes.DuplicationType = "template"
+	DuplicationTypeRequest    currencytypes.DuplicationType = "request"
+	DuplicationTypeHolder     currencytypes.DuplicationType = "holder"
+	DuplicationTypeValue      currencytypes.DuplicationType = "value"
+	DuplicationTypeQuota      currencytypes.DuplicationType = "quota"
 )
 
+// CheckDuplication rejects the operations of a proposal which use the sender,
+// the contract, the template or the credential already used by the previous
+// operations checked by the same OperationProcessor. Issue, Revoke, Suspend,
+// Reinstate, Renew, AuditCredential, OfferCredential, AcceptCredential,
+// DeclineCredential, Renounce, AmendCredential and Reissue share the
+// contract-template-credential keys, and Reissue uses the keys of both the
+// superseded and the issued credentials; AddTemplate, UpdateTemplate,
+// DeprecateTemplate, ShareTemplate, GrantRole and RevokeRole share the
+// contract-template keys. RequestCredential, ApproveCredentialRequest and
+// RejectCredentialRequest share the contract-request keys; the approval also
+// uses the key of the issued credential. RevokeAllByTemplate also uses the
+// contract-template key. SetIssuanceQuota uses the contract key for the quota
+// of the credential service and the contract-template key for the quota of a
+// template.
+//
+// The operations changing the credentials or the holder stats of a holder use
+// the contract-holder key of the holder; the revocation of a credential is
+// cascaded to the credentials of the same holder under the other templates,
+// and the prerequisites and the limits of the templates are checked with the
+// credentials of the holder. AmendCredential finds the holder in the
+// credential state. RevokeAllByHolder changes only the credentials of the
+// holder, so the contract-holder key excludes the other operations on them.
+//
+// The credential value of the template with the unique credential value is
+// checked with the value index of the previous block, so the operations
+// issuing or amending a credential use the contract-template-value key of the
+// value. The limited issuance quota is checked with the quota usage of the
+// previous block, so the issuing operations use the quota usage keys of the
+// limited quotas exclusively, and share the keys of the unlimited quotas;
+// SetIssuanceQuota uses the quota usage key exclusively.
+//
+// The contract key is used exclusively or shared. The changes of the counters
+// of the design by Issue, Revoke, AcceptCredential, Renounce, AmendCredential,
+// Reissue, ApproveCredentialRequest and RevokeAllByHolder, the credential pages
+// and the unlimited quota usages are merged with the other operations of the
+// block, so they share the contract key like the other operations on the
+// credentials. The operations rewriting the design or the bulk revocations,
+// which change the credentials not known by their facts, use the contract key
+// exclusively like RegisterModel and a proposal has at most one of them for a
+// credential service; AddTemplate, RevokeAllByTemplate, PauseService,
+// ResumeService, CloseService, IndexCredentials and SetIssuanceQuota for the
+// credential service. Otherwise the pause switch, the closure or the quota of
+// the credential service set in the design would be overwritten, or the
+// credentials revoked by the bulk revocation would be overwritten by the other
+// operations of the proposal.
 func CheckDuplication(opr *currencyprocessor.OperationProcessor, op base.Operation) error {
 	opr.Lock()
 	defer opr.Unlock()
@@ -25,7 +77,14 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op base.Operati
 	var duplicationTypeSenderID string
 	var duplicationTypeCurrencyID string
 	var duplicationTypeCredentialID []string
-	var duplicationTypeContractID string
+	var duplicationTypeContractID []string
+	var duplicationTypeSharedContractID []string
+	var duplicationTypeTemplateID string
+	var duplicationTypeRequestID string
+	var duplicationTypeHolderID []string
+	var duplicationTypeValueID []string
+	var duplicationTypeQuotaID []string
+	var duplicationTypeSharedQuotaID []string
 	var newAddresses []base.Address
 
 	switch t := op.(type) {
@@ -76,7 +135,7 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op base.Operati
 		}
 		newAddresses = as
 		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
-		duplicationTypeContractID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeContract)
+		duplicationTypeContractID = []string{currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeContract)}
 	case extensioncurrency.Withdraw:
 		fact, ok := t.Fact().(extensioncurrency.WithdrawFact)
 		if !ok {
@@ -89,35 +148,340 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op base.Operati
 			return errors.Errorf("expected CreateServiceFact, not %T", t.Fact())
 		}
 		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
-		duplicationTypeContractID = currencyprocessor.DuplicationKey(fact.Contract().String(), DuplicationTypeContract)
+		duplicationTypeContractID = contractDuplicationKeys(fact.Contract())
 	case credential.AddTemplate:
 		fact, ok := t.Fact().(credential.AddTemplateFact)
 		if !ok {
 			return errors.Errorf("expected AddTemplateFact, not %T", t.Fact())
 		}
 		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeContractID = contractDuplicationKeys(fact.Contract())
+		duplicationTypeTemplateID = templateDuplicationKey(fact.Contract(), fact.TemplateID())
+	case credential.UpdateTemplate:
+		fact, ok := t.Fact().(credential.UpdateTemplateFact)
+		if !ok {
+			return errors.Errorf("expected UpdateTemplateFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeSharedContractID = contractDuplicationKeys(fact.Contract())
+		duplicationTypeTemplateID = templateDuplicationKey(fact.Contract(), fact.TemplateID())
+	case credential.DeprecateTemplate:
+		fact, ok := t.Fact().(credential.DeprecateTemplateFact)
+		if !ok {
+			return errors.Errorf("expected DeprecateTemplateFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeSharedContractID = contractDuplicationKeys(fact.Contract())
+		duplicationTypeTemplateID = templateDuplicationKey(fact.Contract(), fact.TemplateID())
+	case credential.ShareTemplate:
+		fact, ok := t.Fact().(credential.ShareTemplateFact)
+		if !ok {
+			return errors.Errorf("expected ShareTemplateFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeSharedContractID = contractDuplicationKeys(fact.Contract())
+		duplicationTypeTemplateID = templateDuplicationKey(fact.Contract(), fact.TemplateID())
+	case credential.GrantRole:
+		fact, ok := t.Fact().(credential.GrantRoleFact)
+		if !ok {
+			return errors.Errorf("expected GrantRoleFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeSharedContractID = contractDuplicationKeys(fact.Contract())
+		duplicationTypeTemplateID = templateDuplicationKey(fact.Contract(), fact.TemplateID())
+	case credential.RevokeRole:
+		fact, ok := t.Fact().(credential.RevokeRoleFact)
+		if !ok {
+			return errors.Errorf("expected RevokeRoleFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeSharedContractID = contractDuplicationKeys(fact.Contract())
+		duplicationTypeTemplateID = templateDuplicationKey(fact.Contract(), fact.TemplateID())
 	case credential.Issue:
 		fact, ok := t.Fact().(credential.IssueFact)
 		if !ok {
 			return errors.Errorf("expected IssueFact, not %T", t.Fact())
 		}
 		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		var contracts []base.Address
 		var credentials []string
+		var holders []string
+		var values []string
 		for _, v := range fact.Items() {
-			key := currencyprocessor.DuplicationKey(fmt.Sprintf("%s-%s-%s", v.Contract().String(), v.TemplateID(), v.CredentialID()), DuplicationTypeCredential)
-			credentials = append(credentials, key)
+			contracts = append(contracts, v.Contract())
+			credentials = append(credentials, credentialDuplicationKey(v.Contract(), v.TemplateID(), v.CredentialID()))
+			holders = append(holders, holderDuplicationKey(v.Contract(), v.Holder()))
+			values = append(values, valueDuplicationKey(v.Contract(), v.TemplateID(), v.Value()))
+
+			quotas, sharedQuotas := quotaDuplicationKeys(opr, v.Contract(), v.TemplateOwner(), v.TemplateID())
+			duplicationTypeQuotaID = append(duplicationTypeQuotaID, quotas...)
+			duplicationTypeSharedQuotaID = append(duplicationTypeSharedQuotaID, sharedQuotas...)
+		}
+		duplicationTypeHolderID = holders
+		duplicationTypeSharedContractID = contractDuplicationKeys(contracts...)
+		duplicationTypeCredentialID = credentials
+		duplicationTypeValueID = values
+	case credential.Revoke:
+		fact, ok := t.Fact().(credential.RevokeFact)
+		if !ok {
+			return errors.Errorf("expected RevokeFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		var contracts []base.Address
+		var credentials []string
+		var holders []string
+		for _, v := range fact.Items() {
+			contracts = append(contracts, v.Contract())
+			credentials = append(credentials, credentialDuplicationKey(v.Contract(), v.TemplateID(), v.CredentialID()))
+			holders = append(holders, holderDuplicationKey(v.Contract(), v.Holder()))
 		}
+		duplicationTypeHolderID = holders
+		duplicationTypeSharedContractID = contractDuplicationKeys(contracts...)
 		duplicationTypeCredentialID = credentials
+	case credential.Suspend:
+		fact, ok := t.Fact().(credential.SuspendFact)
+		if !ok {
+			return errors.Errorf("expected SuspendFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		var contracts []base.Address
+		var credentials []string
+		var holders []string
+		for _, v := range fact.Items() {
+			contracts = append(contracts, v.Contract())
+			credentials = append(credentials, credentialDuplicationKey(v.Contract(), v.TemplateID(), v.CredentialID()))
+			holders = append(holders, holderDuplicationKey(v.Contract(), v.Holder()))
+		}
+		duplicationTypeHolderID = holders
+		duplicationTypeSharedContractID = contractDuplicationKeys(contracts...)
+		duplicationTypeCredentialID = credentials
+	case credential.Reinstate:
+		fact, ok := t.Fact().(credential.ReinstateFact)
+		if !ok {
+			return errors.Errorf("expected ReinstateFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		var contracts []base.Address
+		var credentials []string
+		var holders []string
+		for _, v := range fact.Items() {
+			contracts = append(contracts, v.Contract())
+			credentials = append(credentials, credentialDuplicationKey(v.Contract(), v.TemplateID(), v.CredentialID()))
+			holders = append(holders, holderDuplicationKey(v.Contract(), v.Holder()))
+		}
+		duplicationTypeHolderID = holders
+		duplicationTypeSharedContractID = contractDuplicationKeys(contracts...)
+		duplicationTypeCredentialID = credentials
+	case credential.Renew:
+		fact, ok := t.Fact().(credential.RenewFact)
+		if !ok {
+			return errors.Errorf("expected RenewFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		var contracts []base.Address
+		var credentials []string
+		var holders []string
+		for _, v := range fact.Items() {
+			contracts = append(contracts, v.Contract())
+			credentials = append(credentials, credentialDuplicationKey(v.Contract(), v.TemplateID(), v.CredentialID()))
+			holders = append(holders, holderDuplicationKey(v.Contract(), v.Holder()))
+		}
+		duplicationTypeHolderID = holders
+		duplicationTypeSharedContractID = contractDuplicationKeys(contracts...)
+		duplicationTypeCredentialID = credentials
+	case credential.AuditCredential:
+		fact, ok := t.Fact().(credential.AuditCredentialFact)
+		if !ok {
+			return errors.Errorf("expected AuditCredentialFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		var contracts []base.Address
+		var credentials []string
+		var holders []string
+		for _, v := range fact.Items() {
+			contracts = append(contracts, v.Contract())
+			credentials = append(credentials, credentialDuplicationKey(v.Contract(), v.TemplateID(), v.CredentialID()))
+			holders = append(holders, holderDuplicationKey(v.Contract(), v.Holder()))
+		}
+		duplicationTypeHolderID = holders
+		duplicationTypeSharedContractID = contractDuplicationKeys(contracts...)
+		duplicationTypeCredentialID = credentials
+	case credential.OfferCredential:
+		fact, ok := t.Fact().(credential.OfferCredentialFact)
+		if !ok {
+			return errors.Errorf("expected OfferCredentialFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		it := fact.Item()
+		duplicationTypeSharedContractID = contractDuplicationKeys(it.Contract())
+		duplicationTypeCredentialID = []string{credentialDuplicationKey(it.Contract(), it.TemplateID(), it.CredentialID())}
+	case credential.AcceptCredential:
+		fact, ok := t.Fact().(credential.AcceptCredentialFact)
+		if !ok {
+			return errors.Errorf("expected AcceptCredentialFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeSharedContractID = contractDuplicationKeys(fact.Contract())
+		duplicationTypeHolderID = []string{holderDuplicationKey(fact.Contract(), fact.Sender())}
+		duplicationTypeCredentialID = []string{
+			credentialDuplicationKey(fact.Contract(), fact.TemplateID(), fact.CredentialID())}
+		if c := offeredCredential(opr, fact.Contract(), fact.TemplateID(), fact.CredentialID()); c != nil {
+			templateOwner := fact.Contract()
+			if tc := c.TemplateContract(); tc != nil {
+				templateOwner = tc
+			}
+
+			duplicationTypeValueID = []string{valueDuplicationKey(fact.Contract(), fact.TemplateID(), c.Value())}
+			duplicationTypeQuotaID, duplicationTypeSharedQuotaID = quotaDuplicationKeys(
+				opr, fact.Contract(), templateOwner, fact.TemplateID())
+		}
+	case credential.DeclineCredential:
+		fact, ok := t.Fact().(credential.DeclineCredentialFact)
+		if !ok {
+			return errors.Errorf("expected DeclineCredentialFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeSharedContractID = contractDuplicationKeys(fact.Contract())
+		duplicationTypeCredentialID = []string{
+			credentialDuplicationKey(fact.Contract(), fact.TemplateID(), fact.CredentialID())}
+	case credential.Reissue:
+		fact, ok := t.Fact().(credential.ReissueFact)
+		if !ok {
+			return errors.Errorf("expected ReissueFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		it := fact.Item()
+		duplicationTypeSharedContractID = contractDuplicationKeys(it.Contract())
+		duplicationTypeHolderID = []string{holderDuplicationKey(it.Contract(), it.Holder())}
+		duplicationTypeCredentialID = []string{
+			credentialDuplicationKey(it.Contract(), it.TemplateID(), fact.CredentialID()),
+			credentialDuplicationKey(it.Contract(), it.TemplateID(), it.CredentialID())}
+		duplicationTypeValueID = []string{valueDuplicationKey(it.Contract(), it.TemplateID(), it.Value())}
+		duplicationTypeQuotaID, duplicationTypeSharedQuotaID = quotaDuplicationKeys(
+			opr, it.Contract(), it.TemplateOwner(), it.TemplateID())
+	case credential.RevokeAllByTemplate:
+		fact, ok := t.Fact().(credential.RevokeAllByTemplateFact)
+		if !ok {
+			return errors.Errorf("expected RevokeAllByTemplateFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeContractID = contractDuplicationKeys(fact.Contract())
+		duplicationTypeTemplateID = templateDuplicationKey(fact.Contract(), fact.TemplateID())
+	case credential.RevokeAllByHolder:
+		fact, ok := t.Fact().(credential.RevokeAllByHolderFact)
+		if !ok {
+			return errors.Errorf("expected RevokeAllByHolderFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeSharedContractID = contractDuplicationKeys(fact.Contract())
+		duplicationTypeHolderID = []string{holderDuplicationKey(fact.Contract(), fact.Holder())}
+	case credential.SetIssuanceQuota:
+		fact, ok := t.Fact().(credential.SetIssuanceQuotaFact)
+		if !ok {
+			return errors.Errorf("expected SetIssuanceQuotaFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		if len(fact.TemplateID()) < 1 {
+			duplicationTypeContractID = contractDuplicationKeys(fact.Contract())
+			duplicationTypeQuotaID = []string{
+				quotaDuplicationKey(state.StateKeyServiceQuotaUsage(fact.Contract()))}
+		} else {
+			duplicationTypeSharedContractID = contractDuplicationKeys(fact.Contract())
+			duplicationTypeTemplateID = templateDuplicationKey(fact.Contract(), fact.TemplateID())
+			duplicationTypeQuotaID = []string{
+				quotaDuplicationKey(state.StateKeyTemplateQuotaUsage(fact.Contract(), fact.TemplateID()))}
+		}
+	case credential.PauseService:
+		fact, ok := t.Fact().(credential.PauseServiceFact)
+		if !ok {
+			return errors.Errorf("expected PauseServiceFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeContractID = contractDuplicationKeys(fact.Contract())
+	case credential.ResumeService:
+		fact, ok := t.Fact().(credential.ResumeServiceFact)
+		if !ok {
+			return errors.Errorf("expected ResumeServiceFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeContractID = contractDuplicationKeys(fact.Contract())
+	case credential.CloseService:
+		fact, ok := t.Fact().(credential.CloseServiceFact)
+		if !ok {
+			return errors.Errorf("expected CloseServiceFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeContractID = contractDuplicationKeys(fact.Contract())
+	case credential.IndexCredentials:
+		fact, ok := t.Fact().(credential.IndexCredentialsFact)
+		if !ok {
+			return errors.Errorf("expected IndexCredentialsFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeContractID = contractDuplicationKeys(fact.Contract())
+	case credential.AmendCredential:
+		fact, ok := t.Fact().(credential.AmendCredentialFact)
+		if !ok {
+			return errors.Errorf("expected AmendCredentialFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeSharedContractID = contractDuplicationKeys(fact.Contract())
+		if holder := credentialHolder(opr, fact.Contract(), fact.TemplateID(), fact.CredentialID()); holder != nil {
+			duplicationTypeHolderID = []string{holderDuplicationKey(fact.Contract(), holder)}
+		}
+		duplicationTypeCredentialID = []string{
+			credentialDuplicationKey(fact.Contract(), fact.TemplateID(), fact.CredentialID())}
+		duplicationTypeValueID = []string{valueDuplicationKey(fact.Contract(), fact.TemplateID(), fact.Value())}
+	case credential.Renounce:
+		fact, ok := t.Fact().(credential.RenounceFact)
+		if !ok {
+			return errors.Errorf("expected RenounceFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeSharedContractID = contractDuplicationKeys(fact.Contract())
+		duplicationTypeHolderID = []string{holderDuplicationKey(fact.Contract(), fact.Sender())}
+		duplicationTypeCredentialID = []string{
+			credentialDuplicationKey(fact.Contract(), fact.TemplateID(), fact.CredentialID())}
+	case credential.RequestCredential:
+		fact, ok := t.Fact().(credential.RequestCredentialFact)
+		if !ok {
+			return errors.Errorf("expected RequestCredentialFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeSharedContractID = contractDuplicationKeys(fact.Contract())
+		duplicationTypeRequestID = requestDuplicationKey(fact.Contract(), fact.RequestID())
+	case credential.ApproveCredentialRequest:
+		fact, ok := t.Fact().(credential.ApproveCredentialRequestFact)
+		if !ok {
+			return errors.Errorf("expected ApproveCredentialRequestFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		it := fact.Item()
+		duplicationTypeSharedContractID = contractDuplicationKeys(it.Contract())
+		duplicationTypeRequestID = requestDuplicationKey(it.Contract(), fact.RequestID())
+		duplicationTypeHolderID = []string{holderDuplicationKey(it.Contract(), it.Holder())}
+		duplicationTypeCredentialID = []string{credentialDuplicationKey(it.Contract(), it.TemplateID(), it.CredentialID())}
+		duplicationTypeValueID = []string{valueDuplicationKey(it.Contract(), it.TemplateID(), it.Value())}
+		duplicationTypeQuotaID, duplicationTypeSharedQuotaID = quotaDuplicationKeys(
+			opr, it.Contract(), it.TemplateOwner(), it.TemplateID())
+	case credential.RejectCredentialRequest:
+		fact, ok := t.Fact().(credential.RejectCredentialRequestFact)
+		if !ok {
+			return errors.Errorf("expected RejectCredentialRequestFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+		duplicationTypeSharedContractID = contractDuplicationKeys(fact.Contract())
+		duplicationTypeRequestID = requestDuplicationKey(fact.Contract(), fact.RequestID())
 	default:
 		return nil
 	}
 
+	// every key is checked before any of them is recorded, so a rejected
+	// operation does not block the following operations of the proposal.
 	if len(duplicationTypeSenderID) > 0 {
 		if _, found := opr.Duplicated[duplicationTypeSenderID]; found {
 			return errors.Errorf("proposal cannot have duplicated sender, %v", duplicationTypeSenderID)
 		}
-
-		opr.Duplicated[duplicationTypeSenderID] = struct{}{}
 	}
 
 	if len(duplicationTypeCurrencyID) > 0 {
@@ -127,28 +491,86 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op base.Operati
 				duplicationTypeCurrencyID,
 			)
 		}
+	}
 
-		opr.Duplicated[duplicationTypeCurrencyID] = struct{}{}
+	for _, v := range duplicationTypeContractID {
+		if isDuplicated(opr, v, true) {
+			return errors.Errorf(
+				"cannot use a duplicated contract for contract model , %v within a proposal",
+				v,
+			)
+		}
 	}
-	if len(duplicationTypeContractID) > 0 {
-		if _, found := opr.Duplicated[duplicationTypeContractID]; found {
+
+	for _, v := range duplicationTypeSharedContractID {
+		if isDuplicated(opr, v, false) {
 			return errors.Errorf(
-				"cannot use a duplicated contract for registering in contract model , %v within a proposal",
-				duplicationTypeSenderID,
+				"cannot use a duplicated contract for contract model , %v within a proposal",
+				v,
 			)
 		}
+	}
 
-		opr.Duplicated[duplicationTypeContractID] = struct{}{}
+	if len(duplicationTypeTemplateID) > 0 {
+		if _, found := opr.Duplicated[duplicationTypeTemplateID]; found {
+			return errors.Errorf(
+				"cannot use a duplicated contract-template for credential model , %v within a proposal",
+				duplicationTypeTemplateID,
+			)
+		}
 	}
-	if len(duplicationTypeCredentialID) > 0 {
-		for _, v := range duplicationTypeCredentialID {
-			if _, found := opr.Duplicated[v]; found {
-				return errors.Errorf(
-					"cannot use a duplicated contract-template-credential for credential model , %v within a proposal",
-					v,
-				)
-			}
-			opr.Duplicated[v] = struct{}{}
+
+	if len(duplicationTypeRequestID) > 0 {
+		if _, found := opr.Duplicated[duplicationTypeRequestID]; found {
+			return errors.Errorf(
+				"cannot use a duplicated contract-request for credential model , %v within a proposal",
+				duplicationTypeRequestID,
+			)
+		}
+	}
+
+	for _, v := range duplicationTypeCredentialID {
+		if _, found := opr.Duplicated[v]; found {
+			return errors.Errorf(
+				"cannot use a duplicated contract-template-credential for credential model , %v within a proposal",
+				v,
+			)
+		}
+	}
+
+	for _, v := range duplicationTypeHolderID {
+		if _, found := opr.Duplicated[v]; found {
+			return errors.Errorf(
+				"cannot use a duplicated contract-holder for credential model , %v within a proposal",
+				v,
+			)
+		}
+	}
+
+	for _, v := range duplicationTypeValueID {
+		if _, found := opr.Duplicated[v]; found {
+			return errors.Errorf(
+				"cannot use a duplicated contract-template-value for credential model , %v within a proposal",
+				v,
+			)
+		}
+	}
+
+	for _, v := range duplicationTypeQuotaID {
+		if isDuplicated(opr, v, true) {
+			return errors.Errorf(
+				"cannot use a duplicated issuance quota for credential model , %v within a proposal",
+				v,
+			)
+		}
+	}
+
+	for _, v := range duplicationTypeSharedQuotaID {
+		if isDuplicated(opr, v, false) {
+			return errors.Errorf(
+				"cannot use a duplicated issuance quota for credential model , %v within a proposal",
+				v,
+			)
 		}
 	}
 
@@ -158,9 +580,216 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op base.Operati
 		}
 	}
 
+	for _, k := range []string{
+		duplicationTypeSenderID, duplicationTypeCurrencyID, duplicationTypeTemplateID, duplicationTypeRequestID,
+	} {
+		if len(k) > 0 {
+			opr.Duplicated[k] = struct{}{}
+		}
+	}
+
+	for _, v := range duplicationTypeContractID {
+		opr.Duplicated[v] = struct{}{}
+	}
+
+	for _, v := range duplicationTypeSharedContractID {
+		opr.Duplicated[sharedDuplicationKey(v)] = struct{}{}
+	}
+
+	for _, v := range duplicationTypeCredentialID {
+		opr.Duplicated[v] = struct{}{}
+	}
+
+	for _, v := range duplicationTypeHolderID {
+		opr.Duplicated[v] = struct{}{}
+	}
+
+	for _, v := range duplicationTypeValueID {
+		opr.Duplicated[v] = struct{}{}
+	}
+
+	for _, v := range duplicationTypeQuotaID {
+		opr.Duplicated[v] = struct{}{}
+	}
+
+	for _, v := range duplicationTypeSharedQuotaID {
+		opr.Duplicated[sharedDuplicationKey(v)] = struct{}{}
+	}
+
 	return nil
 }
 
+// isDuplicated reports whether the key k is used by the previous operations
+// of the proposal. The exclusive use of k conflicts with any use of k, and the
+// shared use of k conflicts only with the exclusive use of k.
+func isDuplicated(opr *currencyprocessor.OperationProcessor, k string, exclusive bool) bool {
+	if _, found := opr.Duplicated[k]; found {
+		return true
+	}
+
+	if !exclusive {
+		return false
+	}
+
+	_, found := opr.Duplicated[sharedDuplicationKey(k)]
+
+	return found
+}
+
+// sharedDuplicationKey returns the key which records the shared use of the key
+// k.
+func sharedDuplicationKey(k string) string {
+	return k + ":shared"
+}
+
+// contractDuplicationKeys returns the contract keys of contracts without
+// duplication.
+func contractDuplicationKeys(contracts ...base.Address) []string {
+	keys := make([]string, 0, len(contracts))
+	founds := map[string]struct{}{}
+
+	for i := range contracts {
+		k := currencyprocessor.DuplicationKey(contracts[i].String(), DuplicationTypeContract)
+		if _, found := founds[k]; found {
+			continue
+		}
+
+		founds[k] = struct{}{}
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+func templateDuplicationKey(contract base.Address, templateID string) string {
+	return currencyprocessor.DuplicationKey(
+		fmt.Sprintf("%s-%s", contract.String(), templateID), DuplicationTypeTemplate)
+}
+
+func credentialDuplicationKey(contract base.Address, templateID, credentialID string) string {
+	return currencyprocessor.DuplicationKey(
+		fmt.Sprintf("%s-%s-%s", contract.String(), templateID, credentialID), DuplicationTypeCredential)
+}
+
+func holderDuplicationKey(contract, holder base.Address) string {
+	return currencyprocessor.DuplicationKey(
+		fmt.Sprintf("%s-%s", contract.String(), holder.String()), DuplicationTypeHolder)
+}
+
+// credentialHolder returns the holder of the credential in the state of the
+// previous block; nil if not found.
+func credentialHolder(
+	opr *currencyprocessor.OperationProcessor, contract base.Address, templateID, credentialID string,
+) base.Address {
+	if opr.GetStateFunc == nil {
+		return nil
+	}
+
+	switch st, found, err := opr.GetStateFunc(state.StateKeyCredential(contract, templateID, credentialID)); {
+	case err != nil, !found:
+		return nil
+	default:
+		c, _, err := state.StateCredentialValue(st)
+		if err != nil {
+			return nil
+		}
+
+		return c.Holder()
+	}
+}
+
+// offeredCredential returns the credential of the offer in the state of the
+// previous block; nil if not found.
+func offeredCredential(
+	opr *currencyprocessor.OperationProcessor, contract base.Address, templateID, credentialID string,
+) *types.Credential {
+	if opr.GetStateFunc == nil {
+		return nil
+	}
+
+	switch st, found, err := opr.GetStateFunc(state.StateKeyOffer(contract, templateID, credentialID)); {
+	case err != nil, !found:
+		return nil
+	default:
+		offer, err := state.StateOfferValue(st)
+		if err != nil {
+			return nil
+		}
+
+		return &offer.Credential
+	}
+}
+
+// valueDuplicationKey returns the key of the credential value of the template
+// of templateID; the unique credential value is checked with the value index
+// of the previous block.
+func valueDuplicationKey(contract base.Address, templateID, value string) string {
+	return currencyprocessor.DuplicationKey(
+		state.StateKeyValueCredentials(contract, templateID, value), DuplicationTypeValue)
+}
+
+func quotaDuplicationKey(key string) string {
+	return currencyprocessor.DuplicationKey(key, DuplicationTypeQuota)
+}
+
+// quotaDuplicationKeys returns the keys of the quota usages of the credential
+// service of contract and of the template of templateID in templateOwner. The
+// limited quota is checked with the usage of the previous block, so its key is
+// used exclusively; the key of the unlimited quota is shared, and the usages
+// are merged. The quota not found in the state of the previous block is
+// regarded as limited.
+func quotaDuplicationKeys(
+	opr *currencyprocessor.OperationProcessor, contract, templateOwner base.Address, templateID string,
+) (exclusive []string, shared []string) {
+	isLimited := func(key string, quota func(base.State) (types.Quota, error)) bool {
+		if opr.GetStateFunc == nil {
+			return true
+		}
+
+		switch st, found, err := opr.GetStateFunc(key); {
+		case err != nil, !found:
+			return true
+		default:
+			q, err := quota(st)
+
+			return err != nil || !q.IsEmpty()
+		}
+	}
+
+	add := func(key string, limited bool) {
+		if limited {
+			exclusive = append(exclusive, quotaDuplicationKey(key))
+		} else {
+			shared = append(shared, quotaDuplicationKey(key))
+		}
+	}
+
+	add(state.StateKeyServiceQuotaUsage(contract), isLimited(
+		state.StateKeyDesign(contract),
+		func(st base.State) (types.Quota, error) {
+			design, err := state.StateDesignValue(st)
+
+			return design.Quota(), err
+		},
+	))
+
+	add(state.StateKeyTemplateQuotaUsage(contract, templateID), isLimited(
+		state.StateKeyTemplate(templateOwner, templateID),
+		func(st base.State) (types.Quota, error) {
+			template, err := state.StateTemplateValue(st)
+
+			return template.Quota(), err
+		},
+	))
+
+	return exclusive, shared
+}
+
+func requestDuplicationKey(contract base.Address, requestID string) string {
+	return currencyprocessor.DuplicationKey(
+		fmt.Sprintf("%s-%s", contract.String(), requestID), DuplicationTypeRequest)
+}
+
 func GetNewProcessor(opr *currencyprocessor.OperationProcessor, op base.Operation) (base.OperationProcessor, bool, error) {
 	switch i, err := opr.GetNewProcessorFromHintset(op); {
 	case err != nil:
@@ -181,7 +810,32 @@ func GetNewProcessor(opr *currencyprocessor.OperationProcessor, op base.Operatio
 		credential.RegisterModel,
 		credential.AddTemplate,
 		credential.Issue,
-		credential.Revoke:
+		credential.Revoke,
+		credential.Suspend,
+		credential.Reinstate,
+		credential.UpdateTemplate,
+		credential.DeprecateTemplate,
+		credential.AuditCredential,
+		credential.ShareTemplate,
+		credential.GrantRole,
+		credential.RevokeRole,
+		credential.OfferCredential,
+		credential.AcceptCredential,
+		credential.DeclineCredential,
+		credential.RequestCredential,
+		credential.ApproveCredentialRequest,
+		credential.RejectCredentialRequest,
+		credential.Renounce,
+		credential.Renew,
+		credential.AmendCredential,
+		credential.Reissue,
+		credential.RevokeAllByTemplate,
+		credential.RevokeAllByHolder,
+		credential.SetIssuanceQuota,
+		credential.PauseService,
+		credential.ResumeService,
+		credential.CloseService,
+		credential.IndexCredentials:
 		return nil, false, errors.Errorf("%T needs SetProcessor", t)
 	default:
 		return nil, false, nil
@@ -0,0 +1,57 @@
+package processor
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+)
+
+func TestCheckDuplication(t *testing.T) {
+	tp := &test.TestProcessor{}
+	tp.Setup(test.NewMockStateGetter())
+
+	p := NewTestCheckDuplicationProcessor(tp)
+	if err := p.CheckCredentialOperations(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckDuplicationBulkRevocation(t *testing.T) {
+	tp := &test.TestProcessor{}
+	tp.Setup(test.NewMockStateGetter())
+
+	p := NewTestCheckDuplicationProcessor(tp)
+	if err := p.CheckBulkRevocationOperations(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckDuplicationHolder(t *testing.T) {
+	tp := &test.TestProcessor{}
+	tp.Setup(test.NewMockStateGetter())
+
+	p := NewTestCheckDuplicationProcessor(tp)
+	if err := p.CheckHolderOperations(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckDuplicationIssuance(t *testing.T) {
+	tp := &test.TestProcessor{}
+	tp.Setup(test.NewMockStateGetter())
+
+	p := NewTestCheckDuplicationProcessor(tp)
+	if err := p.CheckIssuanceOperations(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckDuplicationCloseService(t *testing.T) {
+	tp := &test.TestProcessor{}
+	tp.Setup(test.NewMockStateGetter())
+
+	p := NewTestCheckDuplicationProcessor(tp)
+	if err := p.CheckCloseServiceOperations(); err != nil {
+		t.Fatal(err)
+	}
+}
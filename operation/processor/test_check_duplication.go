@@ -0,0 +1,414 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ProtoconNet/mitum-credential/operation/credential"
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencyprocessor "github.com/ProtoconNet/mitum-currency/v3/operation/processor"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/test"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+// TestCheckDuplicationProcessor feeds the operations of one proposal to a
+// currencyprocessor.OperationProcessor with CheckDuplication and keeps the
+// results of PreProcess.
+type TestCheckDuplicationProcessor struct {
+	*test.TestProcessor
+	opr     *currencyprocessor.OperationProcessor
+	reasons []base.OperationProcessReasonError
+}
+
+func NewTestCheckDuplicationProcessor(tp *test.TestProcessor) TestCheckDuplicationProcessor {
+	return TestCheckDuplicationProcessor{TestProcessor: tp}
+}
+
+func (t *TestCheckDuplicationProcessor) Create() *TestCheckDuplicationProcessor {
+	opr := currencyprocessor.NewOperationProcessor()
+	_ = opr.SetCheckDuplicationFunc(CheckDuplication)
+	_ = opr.SetGetNewProcessorFunc(GetNewProcessor)
+	_ = opr.SetProcessor(credential.AddTemplateHint, credential.NewAddTemplateProcessor())
+	_ = opr.SetProcessor(credential.IssueHint, credential.NewIssueProcessor())
+	_ = opr.SetProcessor(credential.RevokeHint, credential.NewRevokeProcessor())
+	_ = opr.SetProcessor(credential.SuspendHint, credential.NewSuspendProcessor())
+	_ = opr.SetProcessor(credential.ReinstateHint, credential.NewReinstateProcessor())
+	_ = opr.SetProcessor(credential.RenewHint, credential.NewRenewProcessor())
+	_ = opr.SetProcessor(credential.AuditCredentialHint, credential.NewAuditCredentialProcessor())
+	_ = opr.SetProcessor(credential.UpdateTemplateHint, credential.NewUpdateTemplateProcessor())
+	_ = opr.SetProcessor(credential.DeprecateTemplateHint, credential.NewDeprecateTemplateProcessor())
+	_ = opr.SetProcessor(credential.ShareTemplateHint, credential.NewShareTemplateProcessor())
+	_ = opr.SetProcessor(credential.GrantRoleHint, credential.NewGrantRoleProcessor())
+	_ = opr.SetProcessor(credential.RevokeRoleHint, credential.NewRevokeRoleProcessor())
+	_ = opr.SetProcessor(credential.OfferCredentialHint, credential.NewOfferCredentialProcessor())
+	_ = opr.SetProcessor(credential.AcceptCredentialHint, credential.NewAcceptCredentialProcessor())
+	_ = opr.SetProcessor(credential.DeclineCredentialHint, credential.NewDeclineCredentialProcessor())
+	_ = opr.SetProcessor(credential.RequestCredentialHint, credential.NewRequestCredentialProcessor())
+	_ = opr.SetProcessor(credential.ApproveCredentialRequestHint, credential.NewApproveCredentialRequestProcessor())
+	_ = opr.SetProcessor(credential.RejectCredentialRequestHint, credential.NewRejectCredentialRequestProcessor())
+	_ = opr.SetProcessor(credential.RenounceHint, credential.NewRenounceProcessor())
+	_ = opr.SetProcessor(credential.RevokeAllByTemplateHint, credential.NewRevokeAllByTemplateProcessor())
+	_ = opr.SetProcessor(credential.RevokeAllByHolderHint, credential.NewRevokeAllByHolderProcessor())
+	_ = opr.SetProcessor(credential.SetIssuanceQuotaHint, credential.NewSetIssuanceQuotaProcessor())
+	_ = opr.SetProcessor(credential.PauseServiceHint, credential.NewPauseServiceProcessor())
+	_ = opr.SetProcessor(credential.ResumeServiceHint, credential.NewResumeServiceProcessor())
+	_ = opr.SetProcessor(credential.CloseServiceHint, credential.NewCloseServiceProcessor())
+	_ = opr.SetProcessor(credential.IndexCredentialsHint, credential.NewIndexCredentialsProcessor())
+	_ = opr.SetProcessor(credential.AmendCredentialHint, credential.NewAmendCredentialProcessor())
+	_ = opr.SetProcessor(credential.ReissueHint, credential.NewReissueProcessor())
+
+	t.opr, _ = opr.New(base.GenesisHeight, t.GetStateFunc, nil, nil)
+	t.reasons = nil
+
+	return t
+}
+
+func (t *TestCheckDuplicationProcessor) RunPreProcess(ops ...base.Operation) *TestCheckDuplicationProcessor {
+	for i := range ops {
+		_, reason, _ := t.opr.PreProcess(context.Background(), ops[i], t.GetStateFunc)
+		t.reasons = append(t.reasons, reason)
+	}
+
+	return t
+}
+
+// Duplicated returns whether the i-th operation was rejected by
+// CheckDuplication.
+func (t *TestCheckDuplicationProcessor) Duplicated(i int) bool {
+	return t.reasons[i] != nil && strings.HasPrefix(t.reasons[i].Error(), "duplication found")
+}
+
+type duplicationCase struct {
+	op         base.Operation
+	duplicated bool
+}
+
+// check runs the operations of cases in one proposal and checks which of them
+// are rejected as duplicated.
+func (t *TestCheckDuplicationProcessor) check(cases []duplicationCase) error {
+	ops := make([]base.Operation, len(cases))
+	for i := range cases {
+		ops[i] = cases[i].op
+	}
+
+	t.Create().RunPreProcess(ops...)
+
+	for i := range cases {
+		if d := t.Duplicated(i); d != cases[i].duplicated {
+			return errors.Errorf("operation %d, %T; duplicated %v, expected %v, %v", i, cases[i].op, d, cases[i].duplicated, t.reasons[i])
+		}
+	}
+
+	return nil
+}
+
+// CheckCredentialOperations runs one proposal of credential operations from
+// different senders and checks which of them are rejected as duplicated.
+func (t *TestCheckDuplicationProcessor) CheckCredentialOperations() error {
+	accounts := make([]test.Account, 8)
+	for i := range accounts {
+		t.SetAccount(t.NewPrivateKey(fmt.Sprintf("sender%d", i)), 1000, t.GenesisCurrency, accounts[i:i+1], true)
+	}
+
+	contract, holder := accounts[0].Address(), accounts[1].Address()
+	other, another := accounts[5].Address(), accounts[6].Address()
+
+	return t.check([]duplicationCase{
+		{t.issue(accounts[1], contract, holder, "credential0", "value"), false},
+		{t.issue(accounts[2], contract, holder, "credential1", "value"), true},
+		{t.revoke(accounts[2], contract, holder, "credential0"), true},
+		{t.revoke(accounts[2], other, holder, "credential1"), false},
+		{t.suspend(accounts[3], other, holder, "credential1"), true},
+		{t.pauseService(accounts[3], contract), true},
+		{t.addTemplate(accounts[3], contract, "template1"), true},
+		{t.addTemplate(accounts[3], another, "template1"), false},
+		{t.deprecateTemplate(accounts[4], another, "template1"), true},
+		{t.revoke(accounts[4], other, holder, "credential1"), true},
+		{t.suspend(accounts[4], contract, holder, "credential2"), true},
+		{t.indexCredentials(accounts[7], contract, "credential3"), true},
+	})
+}
+
+// CheckBulkRevocationOperations runs one proposal of a bulk revocation and the
+// operations on the credentials of the same credential service, which share
+// the contract key.
+func (t *TestCheckDuplicationProcessor) CheckBulkRevocationOperations() error {
+	accounts := make([]test.Account, 8)
+	for i := range accounts {
+		t.SetAccount(t.NewPrivateKey(fmt.Sprintf("sender%d", i)), 1000, t.GenesisCurrency, accounts[i:i+1], true)
+	}
+
+	contract, holder := accounts[0].Address(), accounts[1].Address()
+	other := accounts[5].Address()
+
+	return t.check([]duplicationCase{
+		{t.suspend(accounts[1], other, holder, "credential0"), false},
+		{t.revokeAllByTemplate(accounts[2], contract, "template0"), false},
+		{t.suspend(accounts[3], contract, holder, "credential0"), true},
+		{t.renew(accounts[3], contract, holder, "credential1"), true},
+		{t.reinstate(accounts[3], contract, holder, "credential2"), true},
+		{t.audit(accounts[3], contract, holder, "credential3"), true},
+		{t.revokeAllByHolder(accounts[4], other, holder), true},
+		{t.reinstate(accounts[4], other, accounts[2].Address(), "credential1"), false},
+		{t.closeService(accounts[6], contract), true},
+		{t.closeService(accounts[6], accounts[7].Address()), false},
+		{t.deprecateTemplate(accounts[7], accounts[7].Address(), "template0"), true},
+	})
+}
+
+// CheckCloseServiceOperations runs one proposal of CloseService and the other
+// operations of the same credential service, which are rejected since the
+// closure revokes the credentials not known by its fact and freezes the
+// credential service.
+func (t *TestCheckDuplicationProcessor) CheckCloseServiceOperations() error {
+	accounts := make([]test.Account, 12)
+	for i := range accounts {
+		t.SetAccount(t.NewPrivateKey(fmt.Sprintf("sender%d", i)), 1000, t.GenesisCurrency, accounts[i:i+1], true)
+	}
+
+	contract, other := accounts[0].Address(), accounts[11].Address()
+	holder, another := accounts[1].Address(), accounts[2].Address()
+
+	return t.check([]duplicationCase{
+		{t.closeService(accounts[1], contract), false},
+		{t.issue(accounts[2], contract, holder, "credential0", "value0"), true},
+		{t.revoke(accounts[3], contract, another, "credential1"), true},
+		{t.suspend(accounts[4], contract, holder, "credential2"), true},
+		{t.renew(accounts[5], contract, another, "credential3"), true},
+		{t.audit(accounts[6], contract, holder, "credential4"), true},
+		{t.revokeAllByHolder(accounts[7], contract, another), true},
+		{t.deprecateTemplate(accounts[8], contract, "template0"), true},
+		{t.setIssuanceQuota(accounts[9], contract, "template0"), true},
+		{t.suspend(accounts[10], other, holder, "credential2"), false},
+	})
+}
+
+// CheckHolderOperations runs one proposal of the operations on the credentials
+// of the holders of a credential service; the operations on the credentials
+// of the same holder are not in the proposal, since the revocation of a
+// credential could be cascaded to the other credentials of the holder.
+func (t *TestCheckDuplicationProcessor) CheckHolderOperations() error {
+	accounts := make([]test.Account, 6)
+	for i := range accounts {
+		t.SetAccount(t.NewPrivateKey(fmt.Sprintf("sender%d", i)), 1000, t.GenesisCurrency, accounts[i:i+1], true)
+	}
+
+	contract, holder, another := accounts[0].Address(), accounts[1].Address(), accounts[2].Address()
+
+	return t.check([]duplicationCase{
+		{t.suspend(accounts[1], contract, holder, "credential0"), false},
+		{t.renew(accounts[2], contract, holder, "credential1"), true},
+		{t.renew(accounts[3], contract, another, "credential2"), false},
+		{t.audit(accounts[4], contract, another, "credential3"), true},
+		{t.reinstate(accounts[5], accounts[5].Address(), holder, "credential0"), false},
+	})
+}
+
+// CheckIssuanceOperations runs one proposal of the operations issuing or
+// revoking the credentials of a credential service; they share the contract
+// key, and are rejected only for the same holder, the same credential value or
+// the same limited issuance quota.
+func (t *TestCheckDuplicationProcessor) CheckIssuanceOperations() error {
+	accounts := make([]test.Account, 12)
+	for i := range accounts {
+		t.SetAccount(t.NewPrivateKey(fmt.Sprintf("sender%d", i)), 1000, t.GenesisCurrency, accounts[i:i+1], true)
+	}
+
+	contract, limited := accounts[0].Address(), accounts[10].Address()
+	holder, another, third := accounts[1].Address(), accounts[2].Address(), accounts[3].Address()
+
+	t.setService(contract, types.Quota{})
+	t.setService(limited, types.NewQuota(10, 0, 0))
+
+	return t.check([]duplicationCase{
+		{t.issue(accounts[1], contract, holder, "credential0", "value0"), false},
+		{t.issue(accounts[2], contract, another, "credential1", "value1"), false},
+		{t.issue(accounts[3], contract, third, "credential2", "value0"), true},
+		{t.revoke(accounts[3], contract, accounts[4].Address(), "credential3"), false},
+		{t.revoke(accounts[4], contract, holder, "credential0"), true},
+		{t.issue(accounts[5], limited, holder, "credential0", "value0"), false},
+		{t.issue(accounts[6], limited, another, "credential1", "value1"), true},
+		{t.setIssuanceQuota(accounts[7], contract, "template0"), true},
+		{t.setIssuanceQuota(accounts[7], accounts[11].Address(), "template0"), false},
+		{t.pauseService(accounts[8], contract), true},
+		{t.indexCredentials(accounts[9], limited, "credential3"), true},
+	})
+}
+
+// setService sets the credential service of contract with template0 limited
+// by quota.
+func (t *TestCheckDuplicationProcessor) setService(contract base.Address, quota types.Quota) {
+	template := types.NewTemplate(
+		"template0", "template", "2024-01-01", "2099-12-31", false, false,
+		"template", "subject", "template", contract, "", "", nil, 0,
+	).SetQuota(quota)
+
+	t.SetState(common.NewBaseState(
+		base.Height(1), state.StateKeyDesign(contract),
+		state.NewDesignStateValue(types.NewDesign(types.NewPolicy([]string{"template0"}, 0, 0))), nil, []util.Hash{},
+	), true)
+	t.SetState(common.NewBaseState(
+		base.Height(1), state.StateKeyTemplate(contract, "template0"),
+		state.NewTemplateStateValue(template), nil, []util.Hash{},
+	), true)
+}
+
+func (t *TestCheckDuplicationProcessor) issue(
+	sender test.Account, contract, holder base.Address, credentialID, value string,
+) base.Operation {
+	op := credential.NewIssue(credential.NewIssueFact([]byte("token"), sender.Address(), []credential.IssueItem{
+		credential.NewIssueItem(
+			contract, holder, "template0", credentialID, value, 1, 2, "did", nil, t.GenesisCurrency),
+	}))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
+
+func (t *TestCheckDuplicationProcessor) revoke(
+	sender test.Account, contract, holder base.Address, credentialID string,
+) base.Operation {
+	op := credential.NewRevoke(credential.NewRevokeFact([]byte("token"), sender.Address(), []credential.RevokeItem{
+		credential.NewRevokeItem(
+			contract, holder, "template0", credentialID, types.RevocationReasonUnspecified, "", t.GenesisCurrency),
+	}))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
+
+func (t *TestCheckDuplicationProcessor) suspend(
+	sender test.Account, contract, holder base.Address, credentialID string,
+) base.Operation {
+	op := credential.NewSuspend(credential.NewSuspendFact([]byte("token"), sender.Address(), []credential.SuspendItem{
+		credential.NewSuspendItem(contract, holder, "template0", credentialID, t.GenesisCurrency),
+	}))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
+
+func (t *TestCheckDuplicationProcessor) addTemplate(
+	sender test.Account, contract base.Address, templateID string,
+) base.Operation {
+	op := credential.NewAddTemplate(credential.NewAddTemplateFact(
+		[]byte("token"), sender.Address(), contract, templateID, "template", "2024-01-01", "2099-12-31",
+		false, false, "template", "subject", "template", sender.Address(), "", "", nil, 0, 0, nil, false, 0, false, 0, 0, t.GenesisCurrency,
+	))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
+
+func (t *TestCheckDuplicationProcessor) setIssuanceQuota(
+	sender test.Account, contract base.Address, templateID string,
+) base.Operation {
+	op := credential.NewSetIssuanceQuota(credential.NewSetIssuanceQuotaFact(
+		[]byte("token"), sender.Address(), contract, templateID, types.NewQuota(10, 0, 0), t.GenesisCurrency,
+	))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
+
+func (t *TestCheckDuplicationProcessor) pauseService(sender test.Account, contract base.Address) base.Operation {
+	op := credential.NewPauseService(credential.NewPauseServiceFact(
+		[]byte("token"), sender.Address(), contract, t.GenesisCurrency,
+	))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
+
+func (t *TestCheckDuplicationProcessor) indexCredentials(
+	sender test.Account, contract base.Address, credentialID string,
+) base.Operation {
+	op := credential.NewIndexCredentials(credential.NewIndexCredentialsFact(
+		[]byte("token"), sender.Address(), contract,
+		[]types.CredentialRef{types.NewCredentialRef("template0", credentialID)}, t.GenesisCurrency,
+	))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
+
+func (t *TestCheckDuplicationProcessor) deprecateTemplate(
+	sender test.Account, contract base.Address, templateID string,
+) base.Operation {
+	op := credential.NewDeprecateTemplate(credential.NewDeprecateTemplateFact(
+		[]byte("token"), sender.Address(), contract, templateID, t.GenesisCurrency,
+	))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
+
+func (t *TestCheckDuplicationProcessor) renew(
+	sender test.Account, contract, holder base.Address, credentialID string,
+) base.Operation {
+	op := credential.NewRenew(credential.NewRenewFact([]byte("token"), sender.Address(), []credential.RenewItem{
+		credential.NewRenewItem(contract, holder, "template0", credentialID, 1, 3, t.GenesisCurrency),
+	}))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
+
+func (t *TestCheckDuplicationProcessor) reinstate(
+	sender test.Account, contract, holder base.Address, credentialID string,
+) base.Operation {
+	op := credential.NewReinstate(credential.NewReinstateFact([]byte("token"), sender.Address(), []credential.ReinstateItem{
+		credential.NewReinstateItem(contract, holder, "template0", credentialID, t.GenesisCurrency),
+	}))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
+
+func (t *TestCheckDuplicationProcessor) audit(
+	sender test.Account, contract, holder base.Address, credentialID string,
+) base.Operation {
+	op := credential.NewAuditCredential(credential.NewAuditCredentialFact(
+		[]byte("token"), sender.Address(), []credential.AuditCredentialItem{
+			credential.NewAuditCredentialItem(contract, holder, "template0", credentialID, t.GenesisCurrency),
+		}))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
+
+func (t *TestCheckDuplicationProcessor) revokeAllByTemplate(
+	sender test.Account, contract base.Address, templateID string,
+) base.Operation {
+	op := credential.NewRevokeAllByTemplate(credential.NewRevokeAllByTemplateFact(
+		[]byte("token"), sender.Address(), contract, templateID, types.RevocationReasonUnspecified, "", t.GenesisCurrency,
+	))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
+
+func (t *TestCheckDuplicationProcessor) revokeAllByHolder(
+	sender test.Account, contract, holder base.Address,
+) base.Operation {
+	op := credential.NewRevokeAllByHolder(credential.NewRevokeAllByHolderFact(
+		[]byte("token"), sender.Address(), contract, holder, types.RevocationReasonUnspecified, "", t.GenesisCurrency,
+	))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
+
+func (t *TestCheckDuplicationProcessor) closeService(sender test.Account, contract base.Address) base.Operation {
+	op := credential.NewCloseService(credential.NewCloseServiceFact(
+		[]byte("token"), sender.Address(), contract, true, t.GenesisCurrency,
+	))
+	_ = op.Sign(sender.Priv(), t.NetworkID)
+
+	return op
+}
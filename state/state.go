@@ -1,13 +1,17 @@
 package state
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"github.com/ProtoconNet/mitum-credential/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"github.com/pkg/errors"
 	"strings"
+	"unicode/utf8"
 )
 
 var (
@@ -76,6 +80,40 @@ func StateKeyDesign(contract base.Address) string {
 	return fmt.Sprintf("%s:%s", StateKeyCredentialPrefix(contract), DesignSuffix)
 }
 
+// PolicyCountsStateValue keeps the changes of the aggregate counters of the
+// policy of the credential service made by an operation; they are added to
+// the design by DesignStateValueMerger.
+type PolicyCountsStateValue struct {
+	HolderCount     int64
+	CredentialCount int64
+	UnindexedCount  int64
+}
+
+func NewPolicyCountsStateValue(holderCount, credentialCount, unindexedCount int64) PolicyCountsStateValue {
+	return PolicyCountsStateValue{
+		HolderCount:     holderCount,
+		CredentialCount: credentialCount,
+		UnindexedCount:  unindexedCount,
+	}
+}
+
+func (pc PolicyCountsStateValue) IsValid([]byte) error {
+	return nil
+}
+
+func (pc PolicyCountsStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		util.Int64ToBytes(pc.HolderCount),
+		util.Int64ToBytes(pc.CredentialCount),
+		util.Int64ToBytes(pc.UnindexedCount),
+	)
+}
+
+// IsZero reports whether the counters are not changed.
+func (pc PolicyCountsStateValue) IsZero() bool {
+	return pc.HolderCount == 0 && pc.CredentialCount == 0 && pc.UnindexedCount == 0
+}
+
 var (
 	TemplateStateValueHint = hint.MustNewHint("mitum-credential-template-state-value-v0.0.1")
 	TemplateSuffix         = "template"
@@ -142,26 +180,133 @@ func StateTemplateValue(st base.State) (types.Template, error) {
 }
 
 var (
-	CredentialStateValueHint = hint.MustNewHint("mitum-credential-credential-state-value-v0.0.1")
-	CredentialSuffix         = "credential"
+	CredentialStateValueHint = hint.MustNewHint("mitum-credential-credential-state-value-v0.0.2")
+	// CredentialStateValueV1Hint is the hint of credential states which have
+	// only the active or revoked status and none of the fields added by the
+	// later versions.
+	CredentialStateValueV1Hint = hint.MustNewHint("mitum-credential-credential-state-value-v0.0.1")
+	CredentialSuffix           = "credential"
 )
 
 type CredentialStateValue struct {
 	hint.BaseHinter
 	Credential types.Credential
-	IsActive   bool
+	Status     types.CredentialStatus
+	Revocation *types.Revocation
+	Approvals  []base.Address
+	// Revision counts the renewals of the credential; RenewedAt is the height
+	// of the last renewal.
+	Revision  uint64
+	RenewedAt base.Height
+	// Version counts the amendments of the value of credential.
+	Version uint64
+	// Supersedes and SupersededBy are the IDs of the credentials of the same
+	// template linked by Reissue.
+	Supersedes   string
+	SupersededBy string
 }
 
-func NewCredentialStateValue(credential types.Credential, isActive bool) CredentialStateValue {
+func NewCredentialStateValue(credential types.Credential, status types.CredentialStatus) CredentialStateValue {
 	return CredentialStateValue{
 		BaseHinter: hint.NewBaseHinter(CredentialStateValueHint),
 		Credential: credential,
-		IsActive:   isActive,
+		Status:     status,
+	}
+}
+
+// SetCredential returns a copy of the value with credential.
+func (cd CredentialStateValue) SetCredential(credential types.Credential) CredentialStateValue {
+	cd.Credential = credential
+
+	return cd
+}
+
+// SetStatus returns a copy of the value with status.
+func (cd CredentialStateValue) SetStatus(status types.CredentialStatus) CredentialStateValue {
+	cd.Status = status
+
+	return cd
+}
+
+// SetRevocation returns a copy of the value which records how the credential
+// was revoked.
+func (cd CredentialStateValue) SetRevocation(revocation types.Revocation) CredentialStateValue {
+	cd.Revocation = &revocation
+
+	return cd
+}
+
+// SetApprovals returns a copy of the value with the auditors which approved
+// the credential.
+func (cd CredentialStateValue) SetApprovals(approvals []base.Address) CredentialStateValue {
+	cd.Approvals = approvals
+
+	return cd
+}
+
+// SetRenewal returns a copy of the value with the revision and the height of
+// the last renewal of the credential.
+func (cd CredentialStateValue) SetRenewal(revision uint64, renewedAt base.Height) CredentialStateValue {
+	cd.Revision = revision
+	cd.RenewedAt = renewedAt
+
+	return cd
+}
+
+// SetVersion returns a copy of the value with the version of the amended
+// value of the credential.
+func (cd CredentialStateValue) SetVersion(version uint64) CredentialStateValue {
+	cd.Version = version
+
+	return cd
+}
+
+// SetSupersedes returns a copy of the value which supersedes the credential of
+// credentialID.
+func (cd CredentialStateValue) SetSupersedes(credentialID string) CredentialStateValue {
+	cd.Supersedes = credentialID
+
+	return cd
+}
+
+// SetSupersededBy returns a copy of the value superseded by the credential of
+// credentialID.
+func (cd CredentialStateValue) SetSupersededBy(credentialID string) CredentialStateValue {
+	cd.SupersededBy = credentialID
+
+	return cd
+}
+
+// IsApprovedBy reports whether auditor already approved the credential.
+func (cd CredentialStateValue) IsApprovedBy(auditor base.Address) bool {
+	for i := range cd.Approvals {
+		if cd.Approvals[i].Equal(auditor) {
+			return true
+		}
 	}
+
+	return false
+}
+
+// isLegacy reports whether the value can be written as the first version,
+// which keeps the hash of the credential states of the old blocks.
+func (cd CredentialStateValue) isLegacy() bool {
+	switch cd.Status {
+	case types.CredentialStatusActive, types.CredentialStatusRevoked:
+	default:
+		return false
+	}
+
+	return cd.Revocation == nil && len(cd.Approvals) < 1 && cd.Revision < 1 && cd.Version < 1 &&
+		len(cd.Supersedes) < 1 && len(cd.SupersededBy) < 1
 }
 
 func (cd CredentialStateValue) Hint() hint.Hint {
-	return cd.BaseHinter.Hint()
+	if cd.isLegacy() {
+		return CredentialStateValueV1Hint
+	}
+
+	return CredentialStateValueHint
 }
 
 func (cd CredentialStateValue) IsValid([]byte) error {
@@ -171,19 +316,68 @@ func (cd CredentialStateValue) IsValid([]byte) error {
 		return e.Wrap(err)
 	}
 
-	if err := cd.Credential.IsValid(nil); err != nil {
+	if err := util.CheckIsValiders(nil, false, cd.Credential, cd.Status); err != nil {
 		return e.Wrap(err)
 	}
 
+	if cd.Revocation != nil {
+		if err := cd.Revocation.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	founds := map[string]struct{}{}
+	for i := range cd.Approvals {
+		if err := cd.Approvals[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[cd.Approvals[i].String()]; found {
+			return e.Wrap(errors.Errorf("duplicated approval of auditor %v", cd.Approvals[i]))
+		}
+
+		founds[cd.Approvals[i].String()] = struct{}{}
+	}
+
 	return nil
 }
 
 func (cd CredentialStateValue) HashBytes() []byte {
-	var v int8
-	if cd.IsActive {
-		v = 1
+	if cd.isLegacy() {
+		var v int8
+		if cd.Status == types.CredentialStatusActive {
+			v = 1
+		}
+
+		return util.ConcatBytesSlice([]byte{byte(v)}, cd.Credential.Bytes())
+	}
+
+	var rb []byte
+	if cd.Revocation != nil {
+		rb = cd.Revocation.Bytes()
+	}
+
+	ab := make([][]byte, len(cd.Approvals))
+	for i := range cd.Approvals {
+		ab[i] = cd.Approvals[i].Bytes()
+	}
+
+	var nb []byte
+	if cd.Revision > 0 {
+		nb = util.ConcatBytesSlice(util.Uint64ToBytes(cd.Revision), cd.RenewedAt.Bytes())
+	}
+
+	var vb []byte
+	if cd.Version > 0 {
+		vb = util.Uint64ToBytes(cd.Version)
+	}
+
+	var sb []byte
+	if len(cd.Supersedes) > 0 || len(cd.SupersededBy) > 0 {
+		sb = util.ConcatBytesSlice([]byte(cd.Supersedes), []byte(":"), []byte(cd.SupersededBy))
 	}
-	return util.ConcatBytesSlice([]byte{byte(v)}, cd.Credential.Bytes())
+
+	return util.ConcatBytesSlice(cd.Status.Bytes(), cd.Credential.Bytes(), rb, util.ConcatBytesSlice(ab...), nb, vb, sb)
 }
 
 func StateKeyCredential(contract base.Address, templateID string, id string) string {
@@ -199,18 +393,32 @@ func IsStateCredentialKey(key string) bool {
 	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, CredentialSuffix)
 }
 
-func StateCredentialValue(st base.State) (types.Credential, bool, error) {
+func StateCredentialValue(st base.State) (types.Credential, types.CredentialStatus, error) {
+	v := st.Value()
+	if v == nil {
+		return types.Credential{}, "", util.ErrNotFound.Errorf("credential not found in State")
+	}
+
+	c, ok := v.(CredentialStateValue)
+	if !ok {
+		return types.Credential{}, "", errors.Errorf("invalid credential value found, %T", v)
+	}
+
+	return c.Credential, c.Status, nil
+}
+
+func StateCredentialStateValue(st base.State) (CredentialStateValue, error) {
 	v := st.Value()
 	if v == nil {
-		return types.Credential{}, false, util.ErrNotFound.Errorf("credential not found in State")
+		return CredentialStateValue{}, util.ErrNotFound.Errorf("credential not found in State")
 	}
 
 	c, ok := v.(CredentialStateValue)
 	if !ok {
-		return types.Credential{}, false, errors.Errorf("invalid credential value found, %T", v)
+		return CredentialStateValue{}, errors.Errorf("invalid credential value found, %T", v)
 	}
 
-	return c.Credential, c.IsActive, nil
+	return c, nil
 }
 
 var (
@@ -273,3 +481,931 @@ func IsStateHolderDIDKey(key string) bool {
 func StateKeyHolderDID(contract base.Address, holder base.Address) string {
 	return fmt.Sprintf("%s:%s:%s", StateKeyCredentialPrefix(contract), holder.String(), HolderDIDSuffix)
 }
+
+var (
+	HolderStatStateValueHint = hint.MustNewHint("mitum-credential-holder-stat-state-value-v0.0.1")
+	HolderStatSuffix         = "holder-stat"
+)
+
+// HolderStatStateValue keeps the number of credentials of a holder in the
+// credential service.
+type HolderStatStateValue struct {
+	hint.BaseHinter
+	Holder types.Holder
+}
+
+func NewHolderStatStateValue(holder types.Holder) HolderStatStateValue {
+	return HolderStatStateValue{
+		BaseHinter: hint.NewBaseHinter(HolderStatStateValueHint),
+		Holder:     holder,
+	}
+}
+
+func (hs HolderStatStateValue) Hint() hint.Hint {
+	return hs.BaseHinter.Hint()
+}
+
+func (hs HolderStatStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential HolderStatStateValue")
+
+	if err := hs.BaseHinter.IsValid(HolderStatStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := hs.Holder.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (hs HolderStatStateValue) HashBytes() []byte {
+	return hs.Holder.Bytes()
+}
+
+func StateHolderStatValue(st base.State) (types.Holder, error) {
+	v := st.Value()
+	if v == nil {
+		return types.Holder{}, util.ErrNotFound.Errorf("holder stat not found in State")
+	}
+
+	hs, ok := v.(HolderStatStateValue)
+	if !ok {
+		return types.Holder{}, errors.Errorf("invalid holder stat value found, %T", v)
+	}
+
+	return hs.Holder, nil
+}
+
+func IsStateHolderStatKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, HolderStatSuffix)
+}
+
+func StateKeyHolderStat(contract base.Address, holder base.Address) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyCredentialPrefix(contract), holder.String(), HolderStatSuffix)
+}
+
+var (
+	CredentialIndexStateValueHint = hint.MustNewHint("mitum-credential-credential-index-state-value-v0.0.1")
+	TemplateCredentialsSuffix     = "template-credentials"
+	HolderCredentialsSuffix       = "holder-credentials"
+	ValueCredentialsSuffix        = "value-credentials"
+)
+
+// CredentialIndexStateValue keeps the references of the credentials, which
+// are not revoked nor renounced, of a holder in the credential service. The
+// credentials issued before the index was introduced are not referred.
+//
+// The index of a credential value of a template with unique value refers the
+// credentials issued with the value; they are not removed when revoked,
+// renounced or amended, so the referred credentials should be checked.
+type CredentialIndexStateValue struct {
+	hint.BaseHinter
+	Credentials []types.CredentialRef
+}
+
+func NewCredentialIndexStateValue(credentials []types.CredentialRef) CredentialIndexStateValue {
+	return CredentialIndexStateValue{
+		BaseHinter:  hint.NewBaseHinter(CredentialIndexStateValueHint),
+		Credentials: credentials,
+	}
+}
+
+func (ci CredentialIndexStateValue) Hint() hint.Hint {
+	return ci.BaseHinter.Hint()
+}
+
+func (ci CredentialIndexStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential CredentialIndexStateValue")
+
+	if err := ci.BaseHinter.IsValid(CredentialIndexStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range ci.Credentials {
+		if err := ci.Credentials[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		k := ci.Credentials[i].String()
+		if _, found := founds[k]; found {
+			return e.Wrap(errors.Errorf("duplicated credential reference, %v", k))
+		}
+
+		founds[k] = struct{}{}
+	}
+
+	return nil
+}
+
+func (ci CredentialIndexStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(ci.Credentials))
+	for i := range ci.Credentials {
+		bs[i] = ci.Credentials[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func StateCredentialIndexValue(st base.State) ([]types.CredentialRef, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("credential index not found in State")
+	}
+
+	ci, ok := v.(CredentialIndexStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid credential index value found, %T", v)
+	}
+
+	return ci.Credentials, nil
+}
+
+func IsStateCredentialIndexKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) &&
+		(strings.HasSuffix(key, HolderCredentialsSuffix) ||
+			strings.HasSuffix(key, ValueCredentialsSuffix))
+}
+
+func StateKeyHolderCredentials(contract base.Address, holder base.Address) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyCredentialPrefix(contract), holder.String(), HolderCredentialsSuffix)
+}
+
+// StateKeyValueCredentials returns the key of the credential index of value of
+// the template of templateID; value is keyed by its hex encoded sha256.
+func StateKeyValueCredentials(contract base.Address, templateID, value string) string {
+	h := sha256.Sum256([]byte(value))
+
+	return fmt.Sprintf(
+		"%s:%s:%s:%s", StateKeyCredentialPrefix(contract), templateID, hex.EncodeToString(h[:]), ValueCredentialsSuffix)
+}
+
+var (
+	CredentialPagesStateValueHint = hint.MustNewHint("mitum-credential-credential-pages-state-value-v0.0.1")
+	CredentialPageStateValueHint  = hint.MustNewHint("mitum-credential-credential-page-state-value-v0.0.1")
+	CredentialPageSuffix          = "credential-page"
+)
+
+// CredentialPagesStateValue keeps the height of the last credential page of a
+// template in the credential service. The credentials of a template are
+// referred by the pages, one per block height, linked from the last page to
+// the first one, so issuing a credential does not rewrite the references of
+// the whole template.
+type CredentialPagesStateValue struct {
+	hint.BaseHinter
+	Last base.Height
+}
+
+func NewCredentialPagesStateValue(last base.Height) CredentialPagesStateValue {
+	return CredentialPagesStateValue{
+		BaseHinter: hint.NewBaseHinter(CredentialPagesStateValueHint),
+		Last:       last,
+	}
+}
+
+func (cp CredentialPagesStateValue) Hint() hint.Hint {
+	return cp.BaseHinter.Hint()
+}
+
+func (cp CredentialPagesStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential CredentialPagesStateValue")
+
+	if err := cp.BaseHinter.IsValid(CredentialPagesStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := cp.Last.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (cp CredentialPagesStateValue) HashBytes() []byte {
+	return cp.Last.Bytes()
+}
+
+func StateCredentialPagesValue(st base.State) (base.Height, error) {
+	v := st.Value()
+	if v == nil {
+		return base.NilHeight, util.ErrNotFound.Errorf("credential pages not found in State")
+	}
+
+	cp, ok := v.(CredentialPagesStateValue)
+	if !ok {
+		return base.NilHeight, errors.Errorf("invalid credential pages value found, %T", v)
+	}
+
+	return cp.Last, nil
+}
+
+func IsStateCredentialPagesKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, TemplateCredentialsSuffix)
+}
+
+func StateKeyTemplateCredentials(contract base.Address, templateID string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyCredentialPrefix(contract), templateID, TemplateCredentialsSuffix)
+}
+
+// CredentialPageStateValue keeps the references of the credentials of a
+// template indexed at a block height. Previous is the height of the previous
+// page; it is base.NilHeight for the first page. The references are not
+// removed when the credentials are revoked or renounced, so the referred
+// credentials should be checked.
+type CredentialPageStateValue struct {
+	hint.BaseHinter
+	Previous    base.Height
+	Credentials []types.CredentialRef
+}
+
+func NewCredentialPageStateValue(previous base.Height, credentials []types.CredentialRef) CredentialPageStateValue {
+	return CredentialPageStateValue{
+		BaseHinter:  hint.NewBaseHinter(CredentialPageStateValueHint),
+		Previous:    previous,
+		Credentials: credentials,
+	}
+}
+
+func (cp CredentialPageStateValue) Hint() hint.Hint {
+	return cp.BaseHinter.Hint()
+}
+
+func (cp CredentialPageStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential CredentialPageStateValue")
+
+	if err := cp.BaseHinter.IsValid(CredentialPageStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if cp.Previous != base.NilHeight {
+		if err := cp.Previous.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	founds := map[string]struct{}{}
+	for i := range cp.Credentials {
+		if err := cp.Credentials[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		k := cp.Credentials[i].String()
+		if _, found := founds[k]; found {
+			return e.Wrap(errors.Errorf("duplicated credential reference, %v", k))
+		}
+
+		founds[k] = struct{}{}
+	}
+
+	return nil
+}
+
+func (cp CredentialPageStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(cp.Credentials)+1)
+	bs[0] = cp.Previous.Bytes()
+	for i := range cp.Credentials {
+		bs[i+1] = cp.Credentials[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func StateCredentialPageValue(st base.State) (CredentialPageStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return CredentialPageStateValue{}, util.ErrNotFound.Errorf("credential page not found in State")
+	}
+
+	cp, ok := v.(CredentialPageStateValue)
+	if !ok {
+		return CredentialPageStateValue{}, errors.Errorf("invalid credential page value found, %T", v)
+	}
+
+	return cp, nil
+}
+
+func IsStateCredentialPageKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, CredentialPageSuffix)
+}
+
+// StateKeyTemplateCredentialPage returns the key of the credential page of the
+// template of templateID at height.
+func StateKeyTemplateCredentialPage(contract base.Address, templateID string, height base.Height) string {
+	return fmt.Sprintf("%s:%s:%d:%s", StateKeyCredentialPrefix(contract), templateID, height, CredentialPageSuffix)
+}
+
+var (
+	RevocationCursorStateValueHint = hint.MustNewHint("mitum-credential-revocation-cursor-state-value-v0.0.1")
+	RevocationCursorSuffix         = "revocation-cursor"
+)
+
+// RevocationCursorStateValue keeps the position in the credential pages of
+// the template of TemplateID, from which the revocation of all the credentials
+// of a template or of the credential service continues; Offset is the index
+// of the next credential in the page at Height. Height is base.NilHeight when
+// no revocation is in progress.
+type RevocationCursorStateValue struct {
+	hint.BaseHinter
+	TemplateID string
+	Height     base.Height
+	Offset     uint64
+}
+
+func NewRevocationCursorStateValue(templateID string, height base.Height, offset uint64) RevocationCursorStateValue {
+	return RevocationCursorStateValue{
+		BaseHinter: hint.NewBaseHinter(RevocationCursorStateValueHint),
+		TemplateID: templateID,
+		Height:     height,
+		Offset:     offset,
+	}
+}
+
+// InProgress reports whether the revocation continues from the cursor.
+func (rc RevocationCursorStateValue) InProgress() bool {
+	return rc.Height != base.NilHeight
+}
+
+func (rc RevocationCursorStateValue) Hint() hint.Hint {
+	return rc.BaseHinter.Hint()
+}
+
+func (rc RevocationCursorStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential RevocationCursorStateValue")
+
+	if err := rc.BaseHinter.IsValid(RevocationCursorStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if !rc.InProgress() {
+		return nil
+	}
+
+	if err := rc.Height.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(rc.TemplateID) < 1 {
+		return e.Wrap(errors.Errorf("empty template id of revocation in progress"))
+	}
+
+	return nil
+}
+
+func (rc RevocationCursorStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(rc.TemplateID),
+		rc.Height.Bytes(),
+		util.Uint64ToBytes(rc.Offset),
+	)
+}
+
+func StateRevocationCursorValue(st base.State) (RevocationCursorStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return RevocationCursorStateValue{}, util.ErrNotFound.Errorf("revocation cursor not found in State")
+	}
+
+	rc, ok := v.(RevocationCursorStateValue)
+	if !ok {
+		return RevocationCursorStateValue{}, errors.Errorf("invalid revocation cursor value found, %T", v)
+	}
+
+	return rc, nil
+}
+
+func IsStateRevocationCursorKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, RevocationCursorSuffix)
+}
+
+// StateKeyServiceRevocationCursor returns the key of the revocation cursor of
+// the credential service, which is closed with its credentials revoked.
+func StateKeyServiceRevocationCursor(contract base.Address) string {
+	return fmt.Sprintf("%s:%s", StateKeyCredentialPrefix(contract), RevocationCursorSuffix)
+}
+
+// StateKeyTemplateRevocationCursor returns the key of the revocation cursor of
+// the template of templateID in the credential service.
+func StateKeyTemplateRevocationCursor(contract base.Address, templateID string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyCredentialPrefix(contract), templateID, RevocationCursorSuffix)
+}
+
+func decodeCredentialRefs(b []byte, enc encoder.Encoder) ([]types.CredentialRef, error) {
+	hs, err := enc.DecodeSlice(b)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]types.CredentialRef, len(hs))
+	for i := range hs {
+		j, ok := hs[i].(types.CredentialRef)
+		if !ok {
+			return nil, errors.Errorf("expected CredentialRef, not %T", hs[i])
+		}
+
+		refs[i] = j
+	}
+
+	return refs, nil
+}
+
+// legacyCredentialStatus keeps credential states written before the status
+// field was introduced decodable; they only carried is_active.
+func legacyCredentialStatus(status string, isActive bool) types.CredentialStatus {
+	if len(status) > 0 {
+		return types.CredentialStatus(status)
+	}
+
+	if isActive {
+		return types.CredentialStatusActive
+	}
+
+	return types.CredentialStatusRevoked
+}
+
+func decodeAddresses(as []string, enc encoder.Encoder) ([]base.Address, error) {
+	if len(as) < 1 {
+		return nil, nil
+	}
+
+	addresses := make([]base.Address, len(as))
+	for i := range as {
+		a, err := base.DecodeAddress(as[i], enc)
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = a
+	}
+
+	return addresses, nil
+}
+
+var (
+	TemplateRolesStateValueHint = hint.MustNewHint("mitum-credential-template-roles-state-value-v0.0.1")
+	TemplateRolesSuffix         = "template-roles"
+)
+
+// TemplateRolesStateValue keeps the role assignments of a template in the
+// credential service. Restricted enables the role-based access of the
+// template; until then, the handlers of contract account keep their authority
+// on the template together with the accounts assigned roles.
+type TemplateRolesStateValue struct {
+	hint.BaseHinter
+	Assignments []types.RoleAssignment
+	Restricted  bool
+}
+
+func NewTemplateRolesStateValue(assignments []types.RoleAssignment) TemplateRolesStateValue {
+	return TemplateRolesStateValue{
+		BaseHinter:  hint.NewBaseHinter(TemplateRolesStateValueHint),
+		Assignments: assignments,
+	}
+}
+
+// SetRestricted returns a copy of the value with the role-based access of the
+// template enabled or not.
+func (tr TemplateRolesStateValue) SetRestricted(restricted bool) TemplateRolesStateValue {
+	tr.Restricted = restricted
+
+	return tr
+}
+
+func (tr TemplateRolesStateValue) Hint() hint.Hint {
+	return tr.BaseHinter.Hint()
+}
+
+func (tr TemplateRolesStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential TemplateRolesStateValue")
+
+	if err := tr.BaseHinter.IsValid(TemplateRolesStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if l := len(tr.Assignments); l > types.MaxTemplateRoleAssignments {
+		return e.Wrap(errors.Errorf("role assignments, %d over max, %d", l, types.MaxTemplateRoleAssignments))
+	}
+
+	founds := map[string]struct{}{}
+	for i := range tr.Assignments {
+		if err := tr.Assignments[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		account := tr.Assignments[i].Account().String()
+		if _, found := founds[account]; found {
+			return e.Wrap(errors.Errorf("duplicated role assignment for %v", account))
+		}
+
+		founds[account] = struct{}{}
+	}
+
+	return nil
+}
+
+func (tr TemplateRolesStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(tr.Assignments))
+	for i := range tr.Assignments {
+		bs[i] = tr.Assignments[i].Bytes()
+	}
+
+	var rb []byte
+	if tr.Restricted {
+		rb = []byte{1}
+	}
+
+	return util.ConcatBytesSlice(util.ConcatBytesSlice(bs...), rb)
+}
+
+func StateTemplateRolesValue(st base.State) ([]types.RoleAssignment, error) {
+	tr, err := StateTemplateRolesStateValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	return tr.Assignments, nil
+}
+
+func StateTemplateRolesStateValue(st base.State) (TemplateRolesStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return TemplateRolesStateValue{}, util.ErrNotFound.Errorf("template roles not found in State")
+	}
+
+	tr, ok := v.(TemplateRolesStateValue)
+	if !ok {
+		return TemplateRolesStateValue{}, errors.Errorf("invalid template roles value found, %T", v)
+	}
+
+	return tr, nil
+}
+
+func IsStateTemplateRolesKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, TemplateRolesSuffix)
+}
+
+func StateKeyTemplateRoles(contract base.Address, templateID string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyCredentialPrefix(contract), templateID, TemplateRolesSuffix)
+}
+
+func decodeRoleAssignments(b []byte, enc encoder.Encoder) ([]types.RoleAssignment, error) {
+	hs, err := enc.DecodeSlice(b)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]types.RoleAssignment, len(hs))
+	for i := range hs {
+		j, ok := hs[i].(types.RoleAssignment)
+		if !ok {
+			return nil, errors.Errorf("expected RoleAssignment, not %T", hs[i])
+		}
+
+		assignments[i] = j
+	}
+
+	return assignments, nil
+}
+
+var (
+	OfferStateValueHint = hint.MustNewHint("mitum-credential-offer-state-value-v0.0.1")
+	OfferSuffix         = "offer"
+)
+
+// OfferStateValue keeps the credential offered to the holder until the holder
+// accepts or declines it.
+type OfferStateValue struct {
+	hint.BaseHinter
+	Credential types.Credential
+	Offerer    base.Address
+	ExpiresAt  base.Height
+	Status     types.OfferStatus
+}
+
+func NewOfferStateValue(
+	credential types.Credential, offerer base.Address, expiresAt base.Height,
+) OfferStateValue {
+	return OfferStateValue{
+		BaseHinter: hint.NewBaseHinter(OfferStateValueHint),
+		Credential: credential,
+		Offerer:    offerer,
+		ExpiresAt:  expiresAt,
+		Status:     types.OfferStatusPending,
+	}
+}
+
+// SetStatus returns a copy of the value with status.
+func (of OfferStateValue) SetStatus(status types.OfferStatus) OfferStateValue {
+	of.Status = status
+
+	return of
+}
+
+// IsOpen reports whether the holder can still accept or decline the offer at
+// height.
+func (of OfferStateValue) IsOpen(height base.Height) bool {
+	return of.Status == types.OfferStatusPending && height <= of.ExpiresAt
+}
+
+// CurrentStatus returns the status of the offer at height; the pending offer
+// after its expiry height is expired.
+func (of OfferStateValue) CurrentStatus(height base.Height) types.OfferStatus {
+	if of.Status == types.OfferStatusPending && height > of.ExpiresAt {
+		return types.OfferStatusExpired
+	}
+
+	return of.Status
+}
+
+func (of OfferStateValue) Hint() hint.Hint {
+	return of.BaseHinter.Hint()
+}
+
+func (of OfferStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential OfferStateValue")
+
+	if err := of.BaseHinter.IsValid(OfferStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, of.Credential, of.Offerer, of.ExpiresAt, of.Status); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (of OfferStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		of.Credential.Bytes(),
+		of.Offerer.Bytes(),
+		of.ExpiresAt.Bytes(),
+		of.Status.Bytes(),
+	)
+}
+
+func StateOfferValue(st base.State) (OfferStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return OfferStateValue{}, util.ErrNotFound.Errorf("credential offer not found in State")
+	}
+
+	of, ok := v.(OfferStateValue)
+	if !ok {
+		return OfferStateValue{}, errors.Errorf("invalid credential offer value found, %T", v)
+	}
+
+	return of, nil
+}
+
+func IsStateOfferKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, OfferSuffix)
+}
+
+func StateKeyOffer(contract base.Address, templateID string, id string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", StateKeyCredentialPrefix(contract), templateID, id, OfferSuffix)
+}
+
+var (
+	RequestStateValueHint = hint.MustNewHint("mitum-credential-request-state-value-v0.0.1")
+	RequestSuffix         = "request"
+)
+
+// RequestStateValue keeps the credential requested by the holder until an
+// issuer of the template approves or rejects it. CredentialID is set by the
+// approval and Reason by the rejection.
+type RequestStateValue struct {
+	hint.BaseHinter
+	Holder       base.Address
+	TemplateID   string
+	Value        string
+	Status       types.RequestStatus
+	CredentialID string
+	Reason       string
+}
+
+func NewRequestStateValue(holder base.Address, templateID, value string) RequestStateValue {
+	return RequestStateValue{
+		BaseHinter: hint.NewBaseHinter(RequestStateValueHint),
+		Holder:     holder,
+		TemplateID: templateID,
+		Value:      value,
+		Status:     types.RequestStatusPending,
+	}
+}
+
+// Approve returns a copy of the value approved by the issuance of credentialID.
+func (rq RequestStateValue) Approve(credentialID string) RequestStateValue {
+	rq.Status = types.RequestStatusApproved
+	rq.CredentialID = credentialID
+
+	return rq
+}
+
+// Reject returns a copy of the value rejected with reason.
+func (rq RequestStateValue) Reject(reason string) RequestStateValue {
+	rq.Status = types.RequestStatusRejected
+	rq.Reason = reason
+
+	return rq
+}
+
+func (rq RequestStateValue) Hint() hint.Hint {
+	return rq.BaseHinter.Hint()
+}
+
+func (rq RequestStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential RequestStateValue")
+
+	if err := rq.BaseHinter.IsValid(RequestStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, rq.Holder, rq.Status); err != nil {
+		return e.Wrap(err)
+	}
+
+	if l := utf8.RuneCountInString(rq.TemplateID); l < 1 || l > types.MaxLengthTemplateID {
+		return e.Wrap(errors.Errorf("0 <= length of template ID <= %d", types.MaxLengthTemplateID))
+	}
+
+	if l := utf8.RuneCountInString(rq.Value); l < 1 || l > types.MaxLengthCredentialValue {
+		return e.Wrap(errors.Errorf("0 <= length of credential value <= %d", types.MaxLengthCredentialValue))
+	}
+
+	if rq.Status == types.RequestStatusApproved && len(rq.CredentialID) < 1 {
+		return e.Wrap(errors.Errorf("empty credential ID of approved request"))
+	}
+
+	if l := utf8.RuneCountInString(rq.Reason); l > types.MaxLengthRejectReason {
+		return e.Wrap(errors.Errorf("length of reject reason <= %d", types.MaxLengthRejectReason))
+	}
+
+	return nil
+}
+
+func (rq RequestStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		rq.Holder.Bytes(),
+		[]byte(rq.TemplateID),
+		[]byte(rq.Value),
+		rq.Status.Bytes(),
+		[]byte(rq.CredentialID),
+		[]byte(rq.Reason),
+	)
+}
+
+func StateRequestValue(st base.State) (RequestStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return RequestStateValue{}, util.ErrNotFound.Errorf("credential request not found in State")
+	}
+
+	rq, ok := v.(RequestStateValue)
+	if !ok {
+		return RequestStateValue{}, errors.Errorf("invalid credential request value found, %T", v)
+	}
+
+	return rq, nil
+}
+
+func IsStateRequestKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, RequestSuffix)
+}
+
+// StateKeyRequest returns the state key of the request; request IDs are unique
+// in the contract account.
+func StateKeyRequest(contract base.Address, id string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyCredentialPrefix(contract), id, RequestSuffix)
+}
+
+var (
+	QuotaUsageStateValueHint = hint.MustNewHint("mitum-credential-quota-usage-state-value-v0.0.1")
+	QuotaUsageSuffix         = "quota-usage"
+)
+
+// QuotaUsageStateValue keeps the number of the credentials issued by the
+// credential service, in total or of a template, against its issuance quota.
+// PeriodIssued counts the credentials issued in the issuance period starting
+// at PeriodStart. The credentials issued before the usage was introduced are
+// not counted.
+type QuotaUsageStateValue struct {
+	hint.BaseHinter
+	Issued       uint64
+	PeriodStart  base.Height
+	PeriodIssued uint64
+}
+
+func NewQuotaUsageStateValue(issued uint64, periodStart base.Height, periodIssued uint64) QuotaUsageStateValue {
+	return QuotaUsageStateValue{
+		BaseHinter:   hint.NewBaseHinter(QuotaUsageStateValueHint),
+		Issued:       issued,
+		PeriodStart:  periodStart,
+		PeriodIssued: periodIssued,
+	}
+}
+
+// At returns a copy of the usage in the issuance period of quota at height;
+// the issued count of the period is reset when the period is over.
+func (qu QuotaUsageStateValue) At(quota types.Quota, height base.Height) QuotaUsageStateValue {
+	if start := quota.PeriodStart(height); quota.Period() < 1 || start != qu.PeriodStart {
+		qu.PeriodStart = start
+		qu.PeriodIssued = 0
+	}
+
+	return qu
+}
+
+func (qu QuotaUsageStateValue) Hint() hint.Hint {
+	return qu.BaseHinter.Hint()
+}
+
+func (qu QuotaUsageStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential QuotaUsageStateValue")
+
+	if err := qu.BaseHinter.IsValid(QuotaUsageStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := qu.PeriodStart.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if qu.PeriodIssued > qu.Issued {
+		return e.Wrap(errors.Errorf("issued in period, %d over issued, %d", qu.PeriodIssued, qu.Issued))
+	}
+
+	return nil
+}
+
+func (qu QuotaUsageStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		util.Uint64ToBytes(qu.Issued),
+		qu.PeriodStart.Bytes(),
+		util.Uint64ToBytes(qu.PeriodIssued),
+	)
+}
+
+func StateQuotaUsageValue(st base.State) (QuotaUsageStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return QuotaUsageStateValue{}, util.ErrNotFound.Errorf("credential quota usage not found in State")
+	}
+
+	qu, ok := v.(QuotaUsageStateValue)
+	if !ok {
+		return QuotaUsageStateValue{}, errors.Errorf("invalid credential quota usage value found, %T", v)
+	}
+
+	return qu, nil
+}
+
+// AddQuotaUsageStateValue keeps the number of the credentials issued by an
+// operation at Height against Quota; it is added to the quota usage by
+// QuotaUsageStateValueMerger.
+type AddQuotaUsageStateValue struct {
+	Quota  types.Quota
+	Height base.Height
+	Issued uint64
+}
+
+func NewAddQuotaUsageStateValue(quota types.Quota, height base.Height, issued uint64) AddQuotaUsageStateValue {
+	return AddQuotaUsageStateValue{
+		Quota:  quota,
+		Height: height,
+		Issued: issued,
+	}
+}
+
+func (qu AddQuotaUsageStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential AddQuotaUsageStateValue")
+
+	if err := util.CheckIsValiders(nil, false, qu.Quota, qu.Height); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (qu AddQuotaUsageStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		qu.Quota.Bytes(),
+		qu.Height.Bytes(),
+		util.Uint64ToBytes(qu.Issued),
+	)
+}
+
+func IsStateQuotaUsageKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, QuotaUsageSuffix)
+}
+
+// StateKeyServiceQuotaUsage returns the state key of the usage of the issuance
+// quota of the credential service.
+func StateKeyServiceQuotaUsage(contract base.Address) string {
+	return fmt.Sprintf("%s:%s", StateKeyCredentialPrefix(contract), QuotaUsageSuffix)
+}
+
+// StateKeyTemplateQuotaUsage returns the state key of the usage of the
+// issuance quota of the template in the credential service.
+func StateKeyTemplateQuotaUsage(contract base.Address, templateID string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyCredentialPrefix(contract), templateID, QuotaUsageSuffix)
+}
@@ -3,6 +3,7 @@ package state
 import (
 	"github.com/ProtoconNet/mitum-credential/types"
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"go.mongodb.org/mongo-driver/bson"
@@ -95,19 +96,56 @@ func (t *TemplateStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 }
 
 func (cd CredentialStateValue) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":      cd.Hint().String(),
-			"credential": cd.Credential,
-			"is_active":  cd.IsActive,
-		},
-	)
+	m := bson.M{
+		"_hint":      cd.Hint().String(),
+		"credential": cd.Credential,
+		"status":     cd.Status,
+	}
+
+	if cd.isLegacy() {
+		m["is_active"] = cd.Status == types.CredentialStatusActive
+	}
+
+	if cd.Revocation != nil {
+		m["revocation"] = cd.Revocation
+	}
+
+	if len(cd.Approvals) > 0 {
+		m["approvals"] = cd.Approvals
+	}
+
+	if cd.Revision > 0 {
+		m["revision"] = cd.Revision
+		m["renewed_at"] = cd.RenewedAt
+	}
+
+	if cd.Version > 0 {
+		m["version"] = cd.Version
+	}
+
+	if len(cd.Supersedes) > 0 {
+		m["supersedes"] = cd.Supersedes
+	}
+
+	if len(cd.SupersededBy) > 0 {
+		m["superseded_by"] = cd.SupersededBy
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type CredentialStateValueBSONUnmarshaler struct {
-	Hint       string   `bson:"_hint"`
-	Credential bson.Raw `bson:"credential"`
-	IsActive   bool     `bson:"is_active"`
+	Hint         string   `bson:"_hint"`
+	Credential   bson.Raw `bson:"credential"`
+	Status       string   `bson:"status"`
+	IsActive     bool     `bson:"is_active"`
+	Revocation   bson.Raw `bson:"revocation,omitempty"`
+	Approvals    []string `bson:"approvals,omitempty"`
+	Revision     uint64   `bson:"revision,omitempty"`
+	RenewedAt    int64    `bson:"renewed_at,omitempty"`
+	Version      uint64   `bson:"version,omitempty"`
+	Supersedes   string   `bson:"supersedes,omitempty"`
+	SupersededBy string   `bson:"superseded_by,omitempty"`
 }
 
 func (cd *CredentialStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -131,7 +169,27 @@ func (cd *CredentialStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error
 	}
 
 	cd.Credential = credential
-	cd.IsActive = u.IsActive
+	cd.Status = legacyCredentialStatus(u.Status, u.IsActive)
+
+	if len(u.Revocation) > 0 {
+		var revocation types.Revocation
+		if err := revocation.DecodeBSON(u.Revocation, enc); err != nil {
+			return e.Wrap(err)
+		}
+
+		cd.Revocation = &revocation
+	}
+
+	approvals, err := decodeAddresses(u.Approvals, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	cd.Approvals = approvals
+	cd.Revision = u.Revision
+	cd.RenewedAt = base.Height(u.RenewedAt)
+	cd.Version = u.Version
+	cd.Supersedes = u.Supersedes
+	cd.SupersededBy = u.SupersededBy
 
 	if err := cd.IsValid(nil); err != nil {
 		return e.Wrap(err)
@@ -175,3 +233,417 @@ func (hd *HolderDIDStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error
 
 	return nil
 }
+
+func (hs HolderStatStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  hs.Hint().String(),
+			"holder": hs.Holder,
+		},
+	)
+}
+
+type HolderStatStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Holder bson.Raw `bson:"holder"`
+}
+
+func (hs *HolderStatStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of HolderStatStateValue")
+
+	var u HolderStatStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	hs.BaseHinter = hint.NewBaseHinter(ht)
+
+	var holder types.Holder
+	if err := holder.DecodeBSON(u.Holder, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	hs.Holder = holder
+
+	if err := hs.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (tr TemplateRolesStateValue) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":       tr.Hint().String(),
+		"assignments": tr.Assignments,
+	}
+
+	if tr.Restricted {
+		m["restricted"] = tr.Restricted
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type TemplateRolesStateValueBSONUnmarshaler struct {
+	Hint        string   `bson:"_hint"`
+	Assignments bson.Raw `bson:"assignments"`
+	Restricted  bool     `bson:"restricted,omitempty"`
+}
+
+func (tr *TemplateRolesStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of TemplateRolesStateValue")
+
+	var u TemplateRolesStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	tr.BaseHinter = hint.NewBaseHinter(ht)
+
+	assignments, err := decodeRoleAssignments(u.Assignments, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	tr.Assignments = assignments
+	tr.Restricted = u.Restricted
+
+	if err := tr.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ci CredentialIndexStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       ci.Hint().String(),
+			"credentials": ci.Credentials,
+		},
+	)
+}
+
+type CredentialIndexStateValueBSONUnmarshaler struct {
+	Hint        string   `bson:"_hint"`
+	Credentials bson.Raw `bson:"credentials"`
+}
+
+func (ci *CredentialIndexStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of CredentialIndexStateValue")
+
+	var u CredentialIndexStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	ci.BaseHinter = hint.NewBaseHinter(ht)
+
+	refs, err := decodeCredentialRefs(u.Credentials, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	ci.Credentials = refs
+
+	if err := ci.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (of OfferStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      of.Hint().String(),
+			"credential": of.Credential,
+			"offerer":    of.Offerer,
+			"expires_at": of.ExpiresAt,
+			"status":     of.Status,
+		},
+	)
+}
+
+type OfferStateValueBSONUnmarshaler struct {
+	Hint       string   `bson:"_hint"`
+	Credential bson.Raw `bson:"credential"`
+	Offerer    string   `bson:"offerer"`
+	ExpiresAt  int64    `bson:"expires_at"`
+	Status     string   `bson:"status"`
+}
+
+func (of *OfferStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of OfferStateValue")
+
+	var u OfferStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	of.BaseHinter = hint.NewBaseHinter(ht)
+
+	var credential types.Credential
+	if err := credential.DecodeBSON(u.Credential, enc); err != nil {
+		return e.Wrap(err)
+	}
+	of.Credential = credential
+
+	offerer, err := base.DecodeAddress(u.Offerer, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	of.Offerer = offerer
+	of.ExpiresAt = base.Height(u.ExpiresAt)
+	of.Status = types.OfferStatus(u.Status)
+
+	if err := of.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (rq RequestStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":         rq.Hint().String(),
+			"holder":        rq.Holder,
+			"template_id":   rq.TemplateID,
+			"value":         rq.Value,
+			"status":        rq.Status,
+			"credential_id": rq.CredentialID,
+			"reason":        rq.Reason,
+		},
+	)
+}
+
+type RequestStateValueBSONUnmarshaler struct {
+	Hint         string `bson:"_hint"`
+	Holder       string `bson:"holder"`
+	TemplateID   string `bson:"template_id"`
+	Value        string `bson:"value"`
+	Status       string `bson:"status"`
+	CredentialID string `bson:"credential_id"`
+	Reason       string `bson:"reason"`
+}
+
+func (rq *RequestStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of RequestStateValue")
+
+	var u RequestStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	rq.BaseHinter = hint.NewBaseHinter(ht)
+
+	holder, err := base.DecodeAddress(u.Holder, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	rq.Holder = holder
+	rq.TemplateID = u.TemplateID
+	rq.Value = u.Value
+	rq.Status = types.RequestStatus(u.Status)
+	rq.CredentialID = u.CredentialID
+	rq.Reason = u.Reason
+
+	if err := rq.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (cp CredentialPagesStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": cp.Hint().String(),
+			"last":  cp.Last,
+		},
+	)
+}
+
+type CredentialPagesStateValueBSONUnmarshaler struct {
+	Hint string `bson:"_hint"`
+	Last int64  `bson:"last"`
+}
+
+func (cp *CredentialPagesStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of CredentialPagesStateValue")
+
+	var u CredentialPagesStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	cp.BaseHinter = hint.NewBaseHinter(ht)
+	cp.Last = base.Height(u.Last)
+
+	if err := cp.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (cp CredentialPageStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       cp.Hint().String(),
+			"previous":    cp.Previous,
+			"credentials": cp.Credentials,
+		},
+	)
+}
+
+type CredentialPageStateValueBSONUnmarshaler struct {
+	Hint        string   `bson:"_hint"`
+	Previous    int64    `bson:"previous"`
+	Credentials bson.Raw `bson:"credentials"`
+}
+
+func (cp *CredentialPageStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of CredentialPageStateValue")
+
+	var u CredentialPageStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	cp.BaseHinter = hint.NewBaseHinter(ht)
+	cp.Previous = base.Height(u.Previous)
+
+	refs, err := decodeCredentialRefs(u.Credentials, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	cp.Credentials = refs
+
+	if err := cp.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (rc RevocationCursorStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       rc.Hint().String(),
+			"template_id": rc.TemplateID,
+			"height":      rc.Height,
+			"offset":      rc.Offset,
+		},
+	)
+}
+
+type RevocationCursorStateValueBSONUnmarshaler struct {
+	Hint       string `bson:"_hint"`
+	TemplateID string `bson:"template_id"`
+	Height     int64  `bson:"height"`
+	Offset     uint64 `bson:"offset"`
+}
+
+func (rc *RevocationCursorStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of RevocationCursorStateValue")
+
+	var u RevocationCursorStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	rc.BaseHinter = hint.NewBaseHinter(ht)
+	rc.TemplateID = u.TemplateID
+	rc.Height = base.Height(u.Height)
+	rc.Offset = u.Offset
+
+	if err := rc.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (qu QuotaUsageStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":         qu.Hint().String(),
+			"issued":        qu.Issued,
+			"period_start":  qu.PeriodStart,
+			"period_issued": qu.PeriodIssued,
+		},
+	)
+}
+
+type QuotaUsageStateValueBSONUnmarshaler struct {
+	Hint         string `bson:"_hint"`
+	Issued       uint64 `bson:"issued"`
+	PeriodStart  int64  `bson:"period_start"`
+	PeriodIssued uint64 `bson:"period_issued"`
+}
+
+func (qu *QuotaUsageStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of QuotaUsageStateValue")
+
+	var u QuotaUsageStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	qu.BaseHinter = hint.NewBaseHinter(ht)
+	qu.Issued = u.Issued
+	qu.PeriodStart = base.Height(u.PeriodStart)
+	qu.PeriodIssued = u.PeriodIssued
+
+	if err := qu.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
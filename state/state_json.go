@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"github.com/ProtoconNet/mitum-credential/types"
 
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -94,22 +95,52 @@ func (t *TemplateStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
 
 type CredentialStateValueJSONMarshaler struct {
 	hint.BaseHinter
-	Credential types.Credential `json:"credential"`
-	IsActive   bool             `json:"is_active"`
+	Credential   types.Credential       `json:"credential"`
+	Status       types.CredentialStatus `json:"status"`
+	IsActive     *bool                  `json:"is_active,omitempty"`
+	Revocation   *types.Revocation      `json:"revocation,omitempty"`
+	Approvals    []base.Address         `json:"approvals,omitempty"`
+	Revision     uint64                 `json:"revision,omitempty"`
+	RenewedAt    base.Height            `json:"renewed_at,omitempty"`
+	Version      uint64                 `json:"version,omitempty"`
+	Supersedes   string                 `json:"supersedes,omitempty"`
+	SupersededBy string                 `json:"superseded_by,omitempty"`
 }
 
 func (cd CredentialStateValue) MarshalJSON() ([]byte, error) {
+	var isActive *bool
+	if cd.isLegacy() {
+		b := cd.Status == types.CredentialStatusActive
+		isActive = &b
+	}
+
 	return util.MarshalJSON(CredentialStateValueJSONMarshaler{
-		BaseHinter: cd.BaseHinter,
-		Credential: cd.Credential,
-		IsActive:   cd.IsActive,
+		BaseHinter:   hint.NewBaseHinter(cd.Hint()),
+		Credential:   cd.Credential,
+		Status:       cd.Status,
+		IsActive:     isActive,
+		Revocation:   cd.Revocation,
+		Approvals:    cd.Approvals,
+		Revision:     cd.Revision,
+		RenewedAt:    cd.RenewedAt,
+		Version:      cd.Version,
+		Supersedes:   cd.Supersedes,
+		SupersededBy: cd.SupersededBy,
 	})
 }
 
 type CredentialStateValueJSONUnmarshaler struct {
-	Hint       hint.Hint       `json:"_hint"`
-	Credential json.RawMessage `json:"credential"`
-	IsActive   bool            `json:"is_active"`
+	Hint         hint.Hint       `json:"_hint"`
+	Credential   json.RawMessage `json:"credential"`
+	Status       string          `json:"status"`
+	IsActive     bool            `json:"is_active"`
+	Revocation   json.RawMessage `json:"revocation"`
+	Approvals    []string        `json:"approvals"`
+	Revision     uint64          `json:"revision"`
+	RenewedAt    base.Height     `json:"renewed_at"`
+	Version      uint64          `json:"version"`
+	Supersedes   string          `json:"supersedes"`
+	SupersededBy string          `json:"superseded_by"`
 }
 
 func (cd *CredentialStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -129,7 +160,27 @@ func (cd *CredentialStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error
 	}
 
 	cd.Credential = credential
-	cd.IsActive = u.IsActive
+	cd.Status = legacyCredentialStatus(u.Status, u.IsActive)
+
+	if len(u.Revocation) > 0 && string(u.Revocation) != "null" {
+		var revocation types.Revocation
+		if err := revocation.DecodeJSON(u.Revocation, enc); err != nil {
+			return e.Wrap(err)
+		}
+
+		cd.Revocation = &revocation
+	}
+
+	approvals, err := decodeAddresses(u.Approvals, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	cd.Approvals = approvals
+	cd.Revision = u.Revision
+	cd.RenewedAt = u.RenewedAt
+	cd.Version = u.Version
+	cd.Supersedes = u.Supersedes
+	cd.SupersededBy = u.SupersededBy
 
 	if err := cd.IsValid(nil); err != nil {
 		return e.Wrap(err)
@@ -171,3 +222,410 @@ func (hd *HolderDIDStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
 	}
 	return nil
 }
+
+type HolderStatStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Holder types.Holder `json:"holder"`
+}
+
+func (hs HolderStatStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(HolderStatStateValueJSONMarshaler{
+		BaseHinter: hs.BaseHinter,
+		Holder:     hs.Holder,
+	})
+}
+
+type HolderStatStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint       `json:"_hint"`
+	Holder json.RawMessage `json:"holder"`
+}
+
+func (hs *HolderStatStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("decode json of HolderStatStateValue")
+
+	var u HolderStatStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	hs.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var holder types.Holder
+	if err := holder.DecodeJSON(u.Holder, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	hs.Holder = holder
+
+	if err := hs.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type TemplateRolesStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Assignments []types.RoleAssignment `json:"assignments"`
+	Restricted  bool                   `json:"restricted,omitempty"`
+}
+
+func (tr TemplateRolesStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TemplateRolesStateValueJSONMarshaler{
+		BaseHinter:  tr.BaseHinter,
+		Assignments: tr.Assignments,
+		Restricted:  tr.Restricted,
+	})
+}
+
+type TemplateRolesStateValueJSONUnmarshaler struct {
+	Hint        hint.Hint       `json:"_hint"`
+	Assignments json.RawMessage `json:"assignments"`
+	Restricted  bool            `json:"restricted"`
+}
+
+func (tr *TemplateRolesStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("decode json of TemplateRolesStateValue")
+
+	var u TemplateRolesStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	tr.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	assignments, err := decodeRoleAssignments(u.Assignments, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	tr.Assignments = assignments
+	tr.Restricted = u.Restricted
+
+	if err := tr.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type CredentialIndexStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Credentials []types.CredentialRef `json:"credentials"`
+}
+
+func (ci CredentialIndexStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CredentialIndexStateValueJSONMarshaler{
+		BaseHinter:  ci.BaseHinter,
+		Credentials: ci.Credentials,
+	})
+}
+
+type CredentialIndexStateValueJSONUnmarshaler struct {
+	Hint        hint.Hint       `json:"_hint"`
+	Credentials json.RawMessage `json:"credentials"`
+}
+
+func (ci *CredentialIndexStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("decode json of CredentialIndexStateValue")
+
+	var u CredentialIndexStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ci.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	refs, err := decodeCredentialRefs(u.Credentials, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	ci.Credentials = refs
+
+	if err := ci.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type OfferStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Credential types.Credential  `json:"credential"`
+	Offerer    base.Address      `json:"offerer"`
+	ExpiresAt  base.Height       `json:"expires_at"`
+	Status     types.OfferStatus `json:"status"`
+}
+
+func (of OfferStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OfferStateValueJSONMarshaler{
+		BaseHinter: of.BaseHinter,
+		Credential: of.Credential,
+		Offerer:    of.Offerer,
+		ExpiresAt:  of.ExpiresAt,
+		Status:     of.Status,
+	})
+}
+
+type OfferStateValueJSONUnmarshaler struct {
+	Hint       hint.Hint       `json:"_hint"`
+	Credential json.RawMessage `json:"credential"`
+	Offerer    string          `json:"offerer"`
+	ExpiresAt  base.Height     `json:"expires_at"`
+	Status     string          `json:"status"`
+}
+
+func (of *OfferStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("decode json of OfferStateValue")
+
+	var u OfferStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	of.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var credential types.Credential
+	if err := credential.DecodeJSON(u.Credential, enc); err != nil {
+		return e.Wrap(err)
+	}
+	of.Credential = credential
+
+	offerer, err := base.DecodeAddress(u.Offerer, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	of.Offerer = offerer
+	of.ExpiresAt = u.ExpiresAt
+	of.Status = types.OfferStatus(u.Status)
+
+	if err := of.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type RequestStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Holder       base.Address        `json:"holder"`
+	TemplateID   string              `json:"template_id"`
+	Value        string              `json:"value"`
+	Status       types.RequestStatus `json:"status"`
+	CredentialID string              `json:"credential_id,omitempty"`
+	Reason       string              `json:"reason,omitempty"`
+}
+
+func (rq RequestStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RequestStateValueJSONMarshaler{
+		BaseHinter:   rq.BaseHinter,
+		Holder:       rq.Holder,
+		TemplateID:   rq.TemplateID,
+		Value:        rq.Value,
+		Status:       rq.Status,
+		CredentialID: rq.CredentialID,
+		Reason:       rq.Reason,
+	})
+}
+
+type RequestStateValueJSONUnmarshaler struct {
+	Hint         hint.Hint `json:"_hint"`
+	Holder       string    `json:"holder"`
+	TemplateID   string    `json:"template_id"`
+	Value        string    `json:"value"`
+	Status       string    `json:"status"`
+	CredentialID string    `json:"credential_id"`
+	Reason       string    `json:"reason"`
+}
+
+func (rq *RequestStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("decode json of RequestStateValue")
+
+	var u RequestStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	rq.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	holder, err := base.DecodeAddress(u.Holder, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	rq.Holder = holder
+	rq.TemplateID = u.TemplateID
+	rq.Value = u.Value
+	rq.Status = types.RequestStatus(u.Status)
+	rq.CredentialID = u.CredentialID
+	rq.Reason = u.Reason
+
+	if err := rq.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type CredentialPagesStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Last base.Height `json:"last"`
+}
+
+func (cp CredentialPagesStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CredentialPagesStateValueJSONMarshaler{
+		BaseHinter: cp.BaseHinter,
+		Last:       cp.Last,
+	})
+}
+
+type CredentialPagesStateValueJSONUnmarshaler struct {
+	Hint hint.Hint   `json:"_hint"`
+	Last base.Height `json:"last"`
+}
+
+func (cp *CredentialPagesStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("decode json of CredentialPagesStateValue")
+
+	var u CredentialPagesStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	cp.BaseHinter = hint.NewBaseHinter(u.Hint)
+	cp.Last = u.Last
+
+	if err := cp.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type CredentialPageStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Previous    base.Height           `json:"previous"`
+	Credentials []types.CredentialRef `json:"credentials"`
+}
+
+func (cp CredentialPageStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CredentialPageStateValueJSONMarshaler{
+		BaseHinter:  cp.BaseHinter,
+		Previous:    cp.Previous,
+		Credentials: cp.Credentials,
+	})
+}
+
+type CredentialPageStateValueJSONUnmarshaler struct {
+	Hint        hint.Hint       `json:"_hint"`
+	Previous    base.Height     `json:"previous"`
+	Credentials json.RawMessage `json:"credentials"`
+}
+
+func (cp *CredentialPageStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("decode json of CredentialPageStateValue")
+
+	var u CredentialPageStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	cp.BaseHinter = hint.NewBaseHinter(u.Hint)
+	cp.Previous = u.Previous
+
+	refs, err := decodeCredentialRefs(u.Credentials, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	cp.Credentials = refs
+
+	if err := cp.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type RevocationCursorStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	TemplateID string      `json:"template_id"`
+	Height     base.Height `json:"height"`
+	Offset     uint64      `json:"offset"`
+}
+
+func (rc RevocationCursorStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevocationCursorStateValueJSONMarshaler{
+		BaseHinter: rc.BaseHinter,
+		TemplateID: rc.TemplateID,
+		Height:     rc.Height,
+		Offset:     rc.Offset,
+	})
+}
+
+type RevocationCursorStateValueJSONUnmarshaler struct {
+	Hint       hint.Hint   `json:"_hint"`
+	TemplateID string      `json:"template_id"`
+	Height     base.Height `json:"height"`
+	Offset     uint64      `json:"offset"`
+}
+
+func (rc *RevocationCursorStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("decode json of RevocationCursorStateValue")
+
+	var u RevocationCursorStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	rc.BaseHinter = hint.NewBaseHinter(u.Hint)
+	rc.TemplateID = u.TemplateID
+	rc.Height = u.Height
+	rc.Offset = u.Offset
+
+	if err := rc.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type QuotaUsageStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Issued       uint64      `json:"issued"`
+	PeriodStart  base.Height `json:"period_start"`
+	PeriodIssued uint64      `json:"period_issued"`
+}
+
+func (qu QuotaUsageStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(QuotaUsageStateValueJSONMarshaler{
+		BaseHinter:   qu.BaseHinter,
+		Issued:       qu.Issued,
+		PeriodStart:  qu.PeriodStart,
+		PeriodIssued: qu.PeriodIssued,
+	})
+}
+
+type QuotaUsageStateValueJSONUnmarshaler struct {
+	Hint         hint.Hint   `json:"_hint"`
+	Issued       uint64      `json:"issued"`
+	PeriodStart  base.Height `json:"period_start"`
+	PeriodIssued uint64      `json:"period_issued"`
+}
+
+func (qu *QuotaUsageStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("decode json of QuotaUsageStateValue")
+
+	var u QuotaUsageStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	qu.BaseHinter = hint.NewBaseHinter(u.Hint)
+	qu.Issued = u.Issued
+	qu.PeriodStart = u.PeriodStart
+	qu.PeriodIssued = u.PeriodIssued
+
+	if err := qu.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,311 @@
+package state
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+// CredentialPageStateValueMerger appends the credential references of the
+// pages merged in a block to the credential page of the block height, so the
+// operations indexing the credentials of a template in the same block do not
+// overwrite each other.
+type CredentialPageStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing CredentialPageStateValue
+	found    bool
+	added    []types.CredentialRef
+	sync.Mutex
+}
+
+func NewCredentialPageStateValueMerger(height base.Height, key string, st base.State) *CredentialPageStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &CredentialPageStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	s.existing = NewCredentialPageStateValue(base.NilHeight, nil)
+	if nst.Value() != nil {
+		s.existing = nst.Value().(CredentialPageStateValue) //nolint:forcetypeassert //...
+		s.found = true
+	}
+
+	return s
+}
+
+func (s *CredentialPageStateValueMerger) Merge(value base.StateValue, ops util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	t, ok := value.(CredentialPageStateValue)
+	if !ok {
+		return errors.Errorf("unsupported credential page state value, %T", value)
+	}
+
+	if !s.found {
+		s.existing.Previous = t.Previous
+		s.found = true
+	}
+
+	s.added = append(s.added, t.Credentials...)
+
+	s.AddOperation(ops)
+
+	return nil
+}
+
+func (s *CredentialPageStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	newValue, err := s.closeValue()
+	if err != nil {
+		return nil, errors.WithMessage(err, "close CredentialPageStateValueMerger")
+	}
+
+	s.BaseStateValueMerger.SetValue(newValue)
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+func (s *CredentialPageStateValueMerger) closeValue() (base.StateValue, error) {
+	founds := map[string]struct{}{}
+	for i := range s.existing.Credentials {
+		founds[s.existing.Credentials[i].String()] = struct{}{}
+	}
+
+	// NOTE the operations are merged in any order; sort the appended
+	// references to keep the page same in every node.
+	sort.Slice(s.added, func(i, j int) bool {
+		return s.added[i].String() < s.added[j].String()
+	})
+
+	refs := make([]types.CredentialRef, len(s.existing.Credentials), len(s.existing.Credentials)+len(s.added))
+	copy(refs, s.existing.Credentials)
+
+	for i := range s.added {
+		k := s.added[i].String()
+		if _, found := founds[k]; found {
+			continue
+		}
+
+		founds[k] = struct{}{}
+		refs = append(refs, s.added[i])
+	}
+
+	return NewCredentialPageStateValue(s.existing.Previous, refs), nil
+}
+
+// NewCredentialPageStateMergeValue returns the state merge value appending
+// the credential references of value to the credential page of key.
+func NewCredentialPageStateMergeValue(key string, value CredentialPageStateValue) base.StateMergeValue {
+	return common.NewBaseStateMergeValue(
+		key,
+		value,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewCredentialPageStateValueMerger(height, key, st)
+		},
+	)
+}
+
+// DesignStateValueMerger adds the changes of the aggregate counters of the
+// policy made by the operations in a block to the design, so the operations
+// issuing or revoking the credentials of a credential service in the same
+// block do not overwrite the counters of each other. The design set by an
+// operation replaces the existing design before the changes are added.
+type DesignStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing *DesignStateValue
+	counts   PolicyCountsStateValue
+	sync.Mutex
+}
+
+func NewDesignStateValueMerger(height base.Height, key string, st base.State) *DesignStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &DesignStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	if nst.Value() != nil {
+		existing := nst.Value().(DesignStateValue) //nolint:forcetypeassert //...
+		s.existing = &existing
+	}
+
+	return s
+}
+
+func (s *DesignStateValueMerger) Merge(value base.StateValue, ops util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case DesignStateValue:
+		s.existing = &t
+	case PolicyCountsStateValue:
+		s.counts.HolderCount += t.HolderCount
+		s.counts.CredentialCount += t.CredentialCount
+		s.counts.UnindexedCount += t.UnindexedCount
+	default:
+		return errors.Errorf("unsupported design state value, %T", value)
+	}
+
+	s.AddOperation(ops)
+
+	return nil
+}
+
+func (s *DesignStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	newValue, err := s.closeValue()
+	if err != nil {
+		return nil, errors.WithMessage(err, "close DesignStateValueMerger")
+	}
+
+	s.BaseStateValueMerger.SetValue(newValue)
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+func (s *DesignStateValueMerger) closeValue() (base.StateValue, error) {
+	if s.existing == nil {
+		return nil, errors.Errorf("credential design not found")
+	}
+
+	design := s.existing.Design
+	policy := design.Policy()
+
+	add := func(name string, count uint64, n int64) (uint64, error) {
+		if n < 0 && uint64(-n) > count {
+			return 0, errors.Errorf("%s count, %d under zero by %d", name, count, n)
+		}
+
+		return uint64(int64(count) + n), nil
+	}
+
+	holderCount, err := add("holder", policy.HolderCount(), s.counts.HolderCount)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialCount, err := add("credential", policy.CredentialCount(), s.counts.CredentialCount)
+	if err != nil {
+		return nil, err
+	}
+
+	unindexedCount, err := add("unindexed credential", policy.UnindexedCount(), s.counts.UnindexedCount)
+	if err != nil {
+		return nil, err
+	}
+
+	newValue := NewDesignStateValue(design.SetPolicy(policy.SetCounts(holderCount, credentialCount, unindexedCount)))
+	if err := newValue.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	return newValue, nil
+}
+
+// NewDesignStateMergeValue returns the state merge value of the design or of
+// the changes of the aggregate counters of the policy of key.
+func NewDesignStateMergeValue(key string, value base.StateValue) base.StateMergeValue {
+	return common.NewBaseStateMergeValue(
+		key,
+		value,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewDesignStateValueMerger(height, key, st)
+		},
+	)
+}
+
+// QuotaUsageStateValueMerger adds the credentials issued by the operations in
+// a block to the quota usage.
+type QuotaUsageStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing QuotaUsageStateValue
+	quota    types.Quota
+	height   base.Height
+	issued   uint64
+	sync.Mutex
+}
+
+func NewQuotaUsageStateValueMerger(height base.Height, key string, st base.State) *QuotaUsageStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &QuotaUsageStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+		height:               height,
+	}
+
+	s.existing = NewQuotaUsageStateValue(0, base.GenesisHeight, 0)
+	if nst.Value() != nil {
+		s.existing = nst.Value().(QuotaUsageStateValue) //nolint:forcetypeassert //...
+	}
+
+	return s
+}
+
+func (s *QuotaUsageStateValueMerger) Merge(value base.StateValue, ops util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	t, ok := value.(AddQuotaUsageStateValue)
+	if !ok {
+		return errors.Errorf("unsupported quota usage state value, %T", value)
+	}
+
+	// NOTE the operations in a block count against the same quota at the same
+	// height.
+	s.quota = t.Quota
+	s.height = t.Height
+	s.issued += t.Issued
+
+	s.AddOperation(ops)
+
+	return nil
+}
+
+func (s *QuotaUsageStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	usage := s.existing.At(s.quota, s.height)
+
+	usage.Issued += s.issued
+	if s.quota.Period() > 0 {
+		usage.PeriodIssued += s.issued
+	}
+
+	s.BaseStateValueMerger.SetValue(usage)
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+// NewQuotaUsageStateMergeValue returns the state merge value adding the
+// credentials of value to the quota usage of key.
+func NewQuotaUsageStateMergeValue(key string, value AddQuotaUsageStateValue) base.StateMergeValue {
+	return common.NewBaseStateMergeValue(
+		key,
+		value,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewQuotaUsageStateValueMerger(height, key, st)
+		},
+	)
+}
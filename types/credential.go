@@ -21,6 +21,9 @@ type Credential struct {
 	validFrom    uint64
 	validUntil   uint64
 	did          string
+	// templateContract is the contract account which published the shared
+	// template; nil for the credential of its own template.
+	templateContract base.Address
 }
 
 func NewCredential(
@@ -45,6 +48,11 @@ func NewCredential(
 }
 
 func (c Credential) Bytes() []byte {
+	var tb []byte
+	if c.templateContract != nil {
+		tb = c.templateContract.Bytes()
+	}
+
 	if c.holder == nil {
 		return util.ConcatBytesSlice(
 			[]byte(c.templateID),
@@ -64,6 +72,7 @@ func (c Credential) Bytes() []byte {
 		util.Uint64ToBytes(c.validFrom),
 		util.Uint64ToBytes(c.validUntil),
 		[]byte(c.did),
+		tb,
 	)
 }
 
@@ -79,6 +88,12 @@ func (c Credential) IsValid([]byte) error {
 		return err
 	}
 
+	if c.templateContract != nil {
+		if err := c.templateContract.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
 	if c.validUntil <= c.validFrom {
 		return common.ErrValOOR.Wrap(errors.Errorf("valid until <= valid from, but %q <= %q", c.validUntil, c.validFrom))
 	}
@@ -137,3 +152,30 @@ func (c Credential) Value() string {
 func (c Credential) DID() string {
 	return c.did
 }
+
+// TemplateContract returns the contract account which published the template
+// of credential; nil means the template of the issuing contract account.
+func (c Credential) TemplateContract() base.Address {
+	return c.templateContract
+}
+
+func (c Credential) SetTemplateContract(contract base.Address) Credential {
+	c.templateContract = contract
+
+	return c
+}
+
+// SetValidity returns a copy of the credential with the new validity period.
+func (c Credential) SetValidity(validFrom, validUntil uint64) Credential {
+	c.validFrom = validFrom
+	c.validUntil = validUntil
+
+	return c
+}
+
+// SetValue returns a copy of the credential with the amended value.
+func (c Credential) SetValue(value string) Credential {
+	c.value = value
+
+	return c
+}
@@ -9,29 +9,34 @@ import (
 )
 
 func (c Credential) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":         c.Hint().String(),
-			"holder":        c.holder,
-			"template_id":   c.templateID,
-			"credential_id": c.credentialID,
-			"value":         c.value,
-			"valid_from":    c.validFrom,
-			"valid_until":   c.validUntil,
-			"did":           c.did,
-		},
-	)
+	m := bson.M{
+		"_hint":         c.Hint().String(),
+		"holder":        c.holder,
+		"template_id":   c.templateID,
+		"credential_id": c.credentialID,
+		"value":         c.value,
+		"valid_from":    c.validFrom,
+		"valid_until":   c.validUntil,
+		"did":           c.did,
+	}
+
+	if c.templateContract != nil {
+		m["template_contract"] = c.templateContract
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type CredentialBSONUnmarshaler struct {
-	Hint         string `bson:"_hint"`
-	Holder       string `bson:"holder"`
-	TemplateID   string `bson:"template_id"`
-	CredentialID string `bson:"credential_id"`
-	Value        string `bson:"value"`
-	ValidFrom    uint64 `bson:"valid_from"`
-	ValidUntil   uint64 `bson:"valid_until"`
-	DID          string `bson:"did"`
+	Hint             string `bson:"_hint"`
+	Holder           string `bson:"holder"`
+	TemplateID       string `bson:"template_id"`
+	CredentialID     string `bson:"credential_id"`
+	Value            string `bson:"value"`
+	ValidFrom        uint64 `bson:"valid_from"`
+	ValidUntil       uint64 `bson:"valid_until"`
+	DID              string `bson:"did"`
+	TemplateContract string `bson:"template_contract"`
 }
 
 func (c *Credential) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -55,5 +60,6 @@ func (c *Credential) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		u.ValidFrom,
 		u.ValidUntil,
 		u.DID,
+		u.TemplateContract,
 	)
 }
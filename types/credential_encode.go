@@ -12,6 +12,7 @@ func (c *Credential) unpack(enc encoder.Encoder, ht hint.Hint,
 	id, v string,
 	vFrom, vUntil uint64,
 	did string,
+	tmplContract string,
 ) error {
 	e := util.StringError("unpack Credential")
 
@@ -27,6 +28,14 @@ func (c *Credential) unpack(enc encoder.Encoder, ht hint.Hint,
 		c.holder = a
 	}
 
+	if len(tmplContract) > 0 {
+		a, err := base.DecodeAddress(tmplContract, enc)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		c.templateContract = a
+	}
+
 	c.templateID = tmplID
 	c.validFrom = vFrom
 	c.validUntil = vUntil
@@ -9,37 +9,40 @@ import (
 
 type CredentialJSONMarshaler struct {
 	hint.BaseHinter
-	Holder       base.Address `json:"holder"`
-	TemplateID   string       `json:"template_id"`
-	CredentialID string       `json:"credential_id"`
-	Value        string       `json:"value"`
-	ValidFrom    uint64       `json:"valid_from"`
-	ValidUntil   uint64       `json:"valid_until"`
-	DID          string       `json:"did"`
+	Holder           base.Address `json:"holder"`
+	TemplateID       string       `json:"template_id"`
+	CredentialID     string       `json:"credential_id"`
+	Value            string       `json:"value"`
+	ValidFrom        uint64       `json:"valid_from"`
+	ValidUntil       uint64       `json:"valid_until"`
+	DID              string       `json:"did"`
+	TemplateContract base.Address `json:"template_contract,omitempty"`
 }
 
 func (c Credential) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(CredentialJSONMarshaler{
-		BaseHinter:   c.BaseHinter,
-		Holder:       c.holder,
-		TemplateID:   c.templateID,
-		CredentialID: c.credentialID,
-		Value:        c.value,
-		ValidFrom:    c.validFrom,
-		ValidUntil:   c.validUntil,
-		DID:          c.did,
+		BaseHinter:       c.BaseHinter,
+		Holder:           c.holder,
+		TemplateID:       c.templateID,
+		CredentialID:     c.credentialID,
+		Value:            c.value,
+		ValidFrom:        c.validFrom,
+		ValidUntil:       c.validUntil,
+		DID:              c.did,
+		TemplateContract: c.templateContract,
 	})
 }
 
 type CredentialJSONUnmarshaler struct {
-	Hint         hint.Hint `json:"_hint"`
-	Holder       string    `json:"holder"`
-	TemplateID   string    `json:"template_id"`
-	CredentialID string    `json:"credential_id"`
-	Value        string    `json:"value"`
-	ValidFrom    uint64    `json:"valid_from"`
-	ValidUntil   uint64    `json:"valid_until"`
-	DID          string    `json:"did"`
+	Hint             hint.Hint `json:"_hint"`
+	Holder           string    `json:"holder"`
+	TemplateID       string    `json:"template_id"`
+	CredentialID     string    `json:"credential_id"`
+	Value            string    `json:"value"`
+	ValidFrom        uint64    `json:"valid_from"`
+	ValidUntil       uint64    `json:"valid_until"`
+	DID              string    `json:"did"`
+	TemplateContract string    `json:"template_contract"`
 }
 
 func (c *Credential) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -58,5 +61,6 @@ func (c *Credential) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		u.ValidFrom,
 		u.ValidUntil,
 		u.DID,
+		u.TemplateContract,
 	)
 }
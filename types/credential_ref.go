@@ -0,0 +1,63 @@
+package types
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var CredentialRefHint = hint.MustNewHint("mitum-credential-credential-ref-v0.0.1")
+
+// CredentialRef refers to a credential in the credential service by its
+// template id and credential id.
+type CredentialRef struct {
+	hint.BaseHinter
+	templateID   string
+	credentialID string
+}
+
+func NewCredentialRef(templateID, credentialID string) CredentialRef {
+	return CredentialRef{
+		BaseHinter:   hint.NewBaseHinter(CredentialRefHint),
+		templateID:   templateID,
+		credentialID: credentialID,
+	}
+}
+
+func (r CredentialRef) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(r.templateID),
+		[]byte(r.credentialID),
+	)
+}
+
+func (r CredentialRef) IsValid([]byte) error {
+	if err := r.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if l := utf8.RuneCountInString(r.templateID); l < 1 || l > MaxLengthTemplateID {
+		return common.ErrValOOR.Wrap(errors.Errorf("0 <= length of template ID <= %d", MaxLengthTemplateID))
+	}
+
+	if l := utf8.RuneCountInString(r.credentialID); l < 1 || l > MaxLengthCredentialID {
+		return common.ErrValOOR.Wrap(errors.Errorf("0 <= length of credential ID <= %d", MaxLengthCredentialID))
+	}
+
+	return nil
+}
+
+func (r CredentialRef) TemplateID() string {
+	return r.templateID
+}
+
+func (r CredentialRef) CredentialID() string {
+	return r.credentialID
+}
+
+func (r CredentialRef) String() string {
+	return r.templateID + ":" + r.credentialID
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (r CredentialRef) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":         r.Hint().String(),
+			"template_id":   r.templateID,
+			"credential_id": r.credentialID,
+		},
+	)
+}
+
+type CredentialRefBSONUnmarshaler struct {
+	Hint         string `bson:"_hint"`
+	TemplateID   string `bson:"template_id"`
+	CredentialID string `bson:"credential_id"`
+}
+
+func (r *CredentialRef) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of CredentialRef")
+
+	var u CredentialRefBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(ht, u.TemplateID, u.CredentialID)
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (r *CredentialRef) unpack(ht hint.Hint, templateID, credentialID string) error {
+	e := util.StringError("unpack CredentialRef")
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.templateID = templateID
+	r.credentialID = credentialID
+
+	if err := r.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type CredentialRefJSONMarshaler struct {
+	hint.BaseHinter
+	TemplateID   string `json:"template_id"`
+	CredentialID string `json:"credential_id"`
+}
+
+func (r CredentialRef) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CredentialRefJSONMarshaler{
+		BaseHinter:   r.BaseHinter,
+		TemplateID:   r.templateID,
+		CredentialID: r.credentialID,
+	})
+}
+
+type CredentialRefJSONUnmarshaler struct {
+	Hint         hint.Hint `json:"_hint"`
+	TemplateID   string    `json:"template_id"`
+	CredentialID string    `json:"credential_id"`
+}
+
+func (r *CredentialRef) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("decode json of CredentialRef")
+
+	var u CredentialRefJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(u.Hint, u.TemplateID, u.CredentialID)
+}
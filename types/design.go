@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"github.com/pkg/errors"
@@ -11,7 +12,12 @@ var DesignHint = hint.MustNewHint("mitum-credential-design-v0.0.1")
 
 type Design struct {
 	hint.BaseHinter
-	policy Policy
+	policy   Policy
+	quota    Quota
+	paused   Bool
+	pausedAt base.Height
+	closed   Bool
+	closedAt base.Height
 }
 
 func NewDesign(policy Policy) Design {
@@ -25,6 +31,7 @@ func (de Design) IsValid([]byte) error {
 	if err := util.CheckIsValiders(nil, false,
 		de.BaseHinter,
 		de.policy,
+		de.quota,
 	); err != nil {
 		return common.ErrValueInvalid.Wrap(errors.Errorf("design: %v", err))
 	}
@@ -33,11 +40,85 @@ func (de Design) IsValid([]byte) error {
 }
 
 func (de Design) Bytes() []byte {
+	var pb []byte
+	if de.paused {
+		pb = util.ConcatBytesSlice(de.paused.Bytes(), de.pausedAt.Bytes())
+	}
+
+	var cb []byte
+	if de.closed {
+		cb = util.ConcatBytesSlice(de.closed.Bytes(), de.closedAt.Bytes())
+	}
+
 	return util.ConcatBytesSlice(
 		de.policy.Bytes(),
+		de.quota.Bytes(),
+		pb,
+		cb,
 	)
 }
 
 func (de Design) Policy() Policy {
 	return de.policy
 }
+
+// SetPolicy returns a copy of the design with policy.
+func (de Design) SetPolicy(policy Policy) Design {
+	de.policy = policy
+
+	return de
+}
+
+// Quota returns the issuance quota of the credential service.
+func (de Design) Quota() Quota {
+	return de.quota
+}
+
+func (de Design) SetQuota(quota Quota) Design {
+	de.quota = quota
+
+	return de
+}
+
+// Paused reports whether the credential service is paused; while paused, no
+// credential is issued and no template is added or changed, but credentials
+// can still be revoked.
+func (de Design) Paused() Bool {
+	return de.paused
+}
+
+// PausedAt returns the height where the credential service was paused.
+func (de Design) PausedAt() base.Height {
+	return de.pausedAt
+}
+
+// SetPaused returns a copy of the design paused at height; resumed if paused
+// is false.
+func (de Design) SetPaused(paused Bool, height base.Height) Design {
+	de.paused = paused
+	de.pausedAt = height
+	if !paused {
+		de.pausedAt = base.GenesisHeight
+	}
+
+	return de
+}
+
+// Closed reports whether the credential service is closed permanently; no
+// template and no credential is added or changed after it is closed.
+func (de Design) Closed() Bool {
+	return de.closed
+}
+
+// ClosedAt returns the height where the credential service was closed.
+func (de Design) ClosedAt() base.Height {
+	return de.closedAt
+}
+
+// SetClosed returns a copy of the design closed at height.
+func (de Design) SetClosed(height base.Height) Design {
+	de.closed = true
+	de.closedAt = height
+
+	return de
+}
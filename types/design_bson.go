@@ -4,6 +4,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
@@ -11,15 +12,29 @@ import (
 func (de Design) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":  de.Hint().String(),
-			"policy": de.policy,
+			"_hint":           de.Hint().String(),
+			"policy":          de.policy,
+			"max_credentials": de.quota.MaxCredentials(),
+			"max_issuances":   de.quota.MaxIssuances(),
+			"issuance_period": de.quota.Period(),
+			"paused":          de.paused,
+			"paused_at":       de.pausedAt,
+			"closed":          de.closed,
+			"closed_at":       de.closedAt,
 		},
 	)
 }
 
 type DesignBSONUnmarshaler struct {
-	Hint   string   `bson:"_hint"`
-	Policy bson.Raw `bson:"policy"`
+	Hint           string      `bson:"_hint"`
+	Policy         bson.Raw    `bson:"policy"`
+	MaxCredentials uint64      `bson:"max_credentials"`
+	MaxIssuances   uint64      `bson:"max_issuances"`
+	IssuancePeriod uint64      `bson:"issuance_period"`
+	Paused         bool        `bson:"paused"`
+	PausedAt       base.Height `bson:"paused_at"`
+	Closed         bool        `bson:"closed"`
+	ClosedAt       base.Height `bson:"closed_at"`
 }
 
 func (de *Design) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -35,5 +50,5 @@ func (de *Design) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return de.unpack(enc, ht, ud.Policy)
+	return de.unpack(enc, ht, ud.Policy, ud.MaxCredentials, ud.MaxIssuances, ud.IssuancePeriod, ud.Paused, ud.PausedAt, ud.Closed, ud.ClosedAt)
 }
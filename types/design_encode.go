@@ -2,16 +2,25 @@ package types
 
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"github.com/pkg/errors"
 )
 
-func (de *Design) unpack(enc encoder.Encoder, ht hint.Hint, bPcy []byte) error {
+func (de *Design) unpack(
+	enc encoder.Encoder, ht hint.Hint, bPcy []byte, maxCredentials, maxIssuances, issuancePeriod uint64,
+	paused bool, pausedAt base.Height, closed bool, closedAt base.Height,
+) error {
 	e := util.StringError("unpack Design")
 
 	de.BaseHinter = hint.NewBaseHinter(ht)
+	de.quota = NewQuota(maxCredentials, maxIssuances, issuancePeriod)
+	de.paused = Bool(paused)
+	de.pausedAt = pausedAt
+	de.closed = Bool(closed)
+	de.closedAt = closedAt
 
 	if hinter, err := enc.Decode(bPcy); err != nil {
 		return e.Wrap(err)
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -10,19 +11,40 @@ import (
 
 type DesignJSONMarshaler struct {
 	hint.BaseHinter
-	Policy Policy `json:"policy"`
+	Policy         Policy      `json:"policy"`
+	MaxCredentials uint64      `json:"max_credentials,omitempty"`
+	MaxIssuances   uint64      `json:"max_issuances,omitempty"`
+	IssuancePeriod uint64      `json:"issuance_period,omitempty"`
+	Paused         Bool        `json:"paused,omitempty"`
+	PausedAt       base.Height `json:"paused_at,omitempty"`
+	Closed         Bool        `json:"closed,omitempty"`
+	ClosedAt       base.Height `json:"closed_at,omitempty"`
 }
 
 func (de Design) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(DesignJSONMarshaler{
-		BaseHinter: de.BaseHinter,
-		Policy:     de.policy,
+		BaseHinter:     de.BaseHinter,
+		Policy:         de.policy,
+		MaxCredentials: de.quota.MaxCredentials(),
+		MaxIssuances:   de.quota.MaxIssuances(),
+		IssuancePeriod: de.quota.Period(),
+		Paused:         de.paused,
+		PausedAt:       de.pausedAt,
+		Closed:         de.closed,
+		ClosedAt:       de.closedAt,
 	})
 }
 
 type DesignJSONUnmarshaler struct {
-	Hint   hint.Hint       `json:"_hint"`
-	Policy json.RawMessage `json:"policy"`
+	Hint           hint.Hint       `json:"_hint"`
+	Policy         json.RawMessage `json:"policy"`
+	MaxCredentials uint64          `json:"max_credentials"`
+	MaxIssuances   uint64          `json:"max_issuances"`
+	IssuancePeriod uint64          `json:"issuance_period"`
+	Paused         bool            `json:"paused"`
+	PausedAt       base.Height     `json:"paused_at"`
+	Closed         bool            `json:"closed"`
+	ClosedAt       base.Height     `json:"closed_at"`
 }
 
 func (de *Design) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -33,5 +55,5 @@ func (de *Design) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return de.unpack(enc, ud.Hint, ud.Policy)
+	return de.unpack(enc, ud.Hint, ud.Policy, ud.MaxCredentials, ud.MaxIssuances, ud.IssuancePeriod, ud.Paused, ud.PausedAt, ud.Closed, ud.ClosedAt)
 }
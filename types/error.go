@@ -0,0 +1,14 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+var (
+	ErrMOutOfServicePeriod = common.ErrMessage("Out of template service period")
+)
+
+var (
+	ErrOutOfServicePeriod = util.NewIDError(string(ErrMOutOfServicePeriod))
+)
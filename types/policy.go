@@ -5,6 +5,7 @@ import (
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
 )
 
 var HolderHint = hint.MustNewHint("mitum-credential-holder-v0.0.1")
@@ -44,19 +45,28 @@ func (h Holder) CredentialCount() uint64 {
 
 var PolicyHint = hint.MustNewHint("mitum-credential-policy-v0.0.1")
 
+// Policy keeps the templates and the aggregate counters of the credential
+// service. The credential count of each holder is kept in the holder-stat
+// state of the holder.
 type Policy struct {
 	hint.BaseHinter
 	templateIDs     []string
 	holders         []Holder
+	holderCount     uint64
 	credentialCount uint64
+	unindexedCount  uint64
+	// holderCounted is false for the policy of previous versions, which has no
+	// holder count; its holder count is derived from the holders.
+	holderCounted bool
 }
 
-func NewPolicy(templates []string, holders []Holder, credentialCount uint64) Policy {
+func NewPolicy(templates []string, holderCount, credentialCount uint64) Policy {
 	return Policy{
 		BaseHinter:      hint.NewBaseHinter(PolicyHint),
 		templateIDs:     templates,
-		holders:         holders,
+		holderCount:     holderCount,
 		credentialCount: credentialCount,
+		holderCounted:   true,
 	}
 }
 
@@ -71,10 +81,22 @@ func (po Policy) Bytes() []byte {
 		hs[i] = h.Bytes()
 	}
 
+	var hb []byte
+	if po.holderCounted {
+		hb = util.Uint64ToBytes(po.holderCount)
+	}
+
+	var ub []byte
+	if po.unindexedCount > 0 {
+		ub = util.Uint64ToBytes(po.unindexedCount)
+	}
+
 	return util.ConcatBytesSlice(
 		util.ConcatBytesSlice(ts...),
 		util.ConcatBytesSlice(hs...),
+		hb,
 		util.Uint64ToBytes(po.credentialCount),
+		ub,
 	)
 }
 
@@ -89,6 +111,11 @@ func (po Policy) IsValid([]byte) error {
 		}
 	}
 
+	if po.unindexedCount > po.credentialCount {
+		return common.ErrValueInvalid.Wrap(errors.Errorf(
+			"unindexed credential count %d over credential count %d", po.unindexedCount, po.credentialCount))
+	}
+
 	return nil
 }
 
@@ -96,10 +123,61 @@ func (po Policy) TemplateIDs() []string {
 	return po.templateIDs
 }
 
+// Holders returns the holders which were kept in the policy by the previous
+// versions. They are moved to the holder-stat states when credentials of the
+// service are issued or revoked, see MigrateHolders.
 func (po Policy) Holders() []Holder {
 	return po.holders
 }
 
+// HolderCount returns the number of holders which have at least one
+// credential.
+func (po Policy) HolderCount() uint64 {
+	return po.holderCount
+}
+
 func (po Policy) CredentialCount() uint64 {
 	return po.credentialCount
 }
+
+// UnindexedCount returns the number of the credentials issued by the previous
+// versions, which are counted but not kept in the credential indexes yet; see
+// IndexCredentials.
+func (po Policy) UnindexedCount() uint64 {
+	return po.unindexedCount
+}
+
+// SetUnindexedCount returns a copy of the policy with the unindexed credential
+// count.
+func (po Policy) SetUnindexedCount(count uint64) Policy {
+	po.unindexedCount = count
+
+	return po
+}
+
+// SetCounts returns a copy of the policy with the aggregate counters; the
+// holders of previous versions are kept.
+func (po Policy) SetCounts(holderCount, credentialCount, unindexedCount uint64) Policy {
+	po.holderCount = holderCount
+	po.credentialCount = credentialCount
+	po.unindexedCount = unindexedCount
+	po.holderCounted = true
+
+	return po
+}
+
+// SetTemplateIDs returns a copy of the policy with templates.
+func (po Policy) SetTemplateIDs(templates []string) Policy {
+	po.templateIDs = templates
+
+	return po
+}
+
+// MigrateHolders returns the policy without the holders of previous versions;
+// the returned holders should be kept in the holder-stat states.
+func (po Policy) MigrateHolders() (Policy, []Holder) {
+	holders := po.holders
+	po.holders = nil
+
+	return po, holders
+}
@@ -41,21 +41,28 @@ func (h *Holder) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 }
 
 func (po Policy) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":            po.Hint().String(),
-			"templates":        po.templateIDs,
-			"holders":          po.holders,
-			"credential_count": po.credentialCount,
-		},
-	)
+	m := bson.M{
+		"_hint":            po.Hint().String(),
+		"templates":        po.templateIDs,
+		"holders":          po.holders,
+		"credential_count": po.credentialCount,
+		"unindexed_count":  po.unindexedCount,
+	}
+
+	if po.holderCounted {
+		m["holder_count"] = po.holderCount
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type PolicyBSONUnmarshaler struct {
 	Hint            string   `bson:"_hint"`
 	Templates       []string `bson:"templates"`
 	Holders         bson.Raw `bson:"holders"`
+	HolderCount     *uint64  `bson:"holder_count"`
 	CredentialCount uint64   `bson:"credential_count"`
+	UnindexedCount  *uint64  `bson:"unindexed_count"`
 }
 
 func (po *Policy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -71,5 +78,5 @@ func (po *Policy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return po.unpack(enc, ht, upo.Templates, upo.Holders, upo.CredentialCount)
+	return po.unpack(enc, ht, upo.Templates, upo.Holders, upo.HolderCount, upo.CredentialCount, upo.UnindexedCount)
 }
@@ -33,7 +33,10 @@ func (h *Holder) unpack(enc encoder.Encoder, ht hint.Hint, adr string, count uin
 	return nil
 }
 
-func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint, tmplIDs []string, bHolders []byte, count uint64) error {
+func (po *Policy) unpack(
+	enc encoder.Encoder, ht hint.Hint, tmplIDs []string, bHolders []byte, holderCount *uint64, count uint64,
+	unindexedCount *uint64,
+) error {
 	e := util.StringError("unpack Policy")
 
 	po.BaseHinter = hint.NewBaseHinter(ht)
@@ -54,7 +57,33 @@ func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint, tmplIDs []string, bH
 		holders[i] = j
 	}
 	po.holders = holders
+
+	// NOTE the policy of previous versions has no holder count, but keeps every
+	// holder; the holders of no credentials left by the revocations of
+	// previous versions are not counted.
+	if holderCount != nil {
+		po.holderCount = *holderCount
+		po.holderCounted = true
+	} else {
+		var n uint64
+		for i := range holders {
+			if holders[i].CredentialCount() > 0 {
+				n++
+			}
+		}
+		po.holderCount = n
+	}
+
 	po.credentialCount = count
+
+	// NOTE the credentials of the policy of previous versions were issued
+	// before the credential indexes were introduced.
+	if unindexedCount != nil {
+		po.unindexedCount = *unindexedCount
+	} else {
+		po.unindexedCount = count
+	}
+
 	if err := po.IsValid(nil); err != nil {
 		return e.Wrap(err)
 	}
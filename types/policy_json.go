@@ -43,15 +43,24 @@ type PolicyJSONMarshaler struct {
 	hint.BaseHinter
 	Templates       []string `json:"templates"`
 	Holders         []Holder `json:"holders"`
+	HolderCount     *uint64  `json:"holder_count,omitempty"`
 	CredentialCount uint64   `json:"credential_count"`
+	UnindexedCount  uint64   `json:"unindexed_count"`
 }
 
 func (po Policy) MarshalJSON() ([]byte, error) {
+	var holderCount *uint64
+	if po.holderCounted {
+		holderCount = &po.holderCount
+	}
+
 	return util.MarshalJSON(PolicyJSONMarshaler{
 		BaseHinter:      po.BaseHinter,
 		Templates:       po.templateIDs,
 		Holders:         po.holders,
+		HolderCount:     holderCount,
 		CredentialCount: po.credentialCount,
+		UnindexedCount:  po.unindexedCount,
 	})
 }
 
@@ -59,7 +68,9 @@ type PolicyJSONUnmarshaler struct {
 	Hint            hint.Hint       `json:"_hint"`
 	Templates       []string        `json:"templates"`
 	Holders         json.RawMessage `json:"holders"`
+	HolderCount     *uint64         `json:"holder_count"`
 	CredentialCount uint64          `json:"credential_count"`
+	UnindexedCount  *uint64         `json:"unindexed_count"`
 }
 
 func (po *Policy) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -70,5 +81,5 @@ func (po *Policy) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return po.unpack(enc, upo.Hint, upo.Templates, upo.Holders, upo.CredentialCount)
+	return po.unpack(enc, upo.Hint, upo.Templates, upo.Holders, upo.HolderCount, upo.CredentialCount, upo.UnindexedCount)
 }
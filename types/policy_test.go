@@ -0,0 +1,48 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+func TestPolicyLegacyBytes(t *testing.T) {
+	enc := jsonenc.NewEncoder()
+
+	var po Policy
+	if err := po.unpack(enc, PolicyHint, []string{"t1", "t2"}, nil, nil, 3, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// NOTE the policy of previous versions has no holder count.
+	legacy := util.ConcatBytesSlice([]byte("t1"), []byte("t2"), util.Uint64ToBytes(3))
+	withUnindexed := util.ConcatBytesSlice(legacy, util.Uint64ToBytes(3))
+
+	if b := po.Bytes(); !bytes.Equal(b, withUnindexed) {
+		t.Fatalf("legacy policy bytes changed, %x != %x", b, withUnindexed)
+	}
+
+	if b := po.SetUnindexedCount(0).SetTemplateIDs([]string{"t1", "t2"}).Bytes(); !bytes.Equal(b, legacy) {
+		t.Fatalf("legacy policy bytes changed, %x != %x", b, legacy)
+	}
+
+	b, err := enc.Marshal(po)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var upo PolicyJSONUnmarshaler
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		t.Fatal(err)
+	}
+
+	if upo.HolderCount != nil {
+		t.Fatalf("holder count of legacy policy marshaled, %d", *upo.HolderCount)
+	}
+
+	if b := NewPolicy([]string{"t1", "t2"}, 0, 3).Bytes(); bytes.Equal(b, legacy) {
+		t.Fatal("policy with holder count has the legacy bytes")
+	}
+}
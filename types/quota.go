@@ -0,0 +1,77 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+// Quota limits the issuance of the credentials of a credential service or of
+// a template; maxCredentials limits the credentials issued in total and
+// maxIssuances limits the credentials issued in every period blocks. 0 means
+// unlimited.
+type Quota struct {
+	maxCredentials uint64
+	maxIssuances   uint64
+	period         uint64
+}
+
+func NewQuota(maxCredentials, maxIssuances, period uint64) Quota {
+	return Quota{
+		maxCredentials: maxCredentials,
+		maxIssuances:   maxIssuances,
+		period:         period,
+	}
+}
+
+func (q Quota) Bytes() []byte {
+	if q.IsEmpty() {
+		return nil
+	}
+
+	return util.ConcatBytesSlice(
+		util.Uint64ToBytes(q.maxCredentials),
+		util.Uint64ToBytes(q.maxIssuances),
+		util.Uint64ToBytes(q.period),
+	)
+}
+
+func (q Quota) IsValid([]byte) error {
+	switch {
+	case q.maxIssuances > 0 && q.period < 1:
+		return common.ErrValueInvalid.Errorf("max issuances without issuance period")
+	case q.maxIssuances < 1 && q.period > 0:
+		return common.ErrValueInvalid.Errorf("issuance period without max issuances")
+	}
+
+	return nil
+}
+
+func (q Quota) IsEmpty() bool {
+	return q.maxCredentials < 1 && q.maxIssuances < 1 && q.period < 1
+}
+
+func (q Quota) MaxCredentials() uint64 {
+	return q.maxCredentials
+}
+
+func (q Quota) MaxIssuances() uint64 {
+	return q.maxIssuances
+}
+
+// Period returns the number of blocks in which at most MaxIssuances
+// credentials are issued.
+func (q Quota) Period() uint64 {
+	return q.period
+}
+
+// PeriodStart returns the height where the period of height starts; the
+// periods are aligned to the genesis height. It is the genesis height without
+// issuance period.
+func (q Quota) PeriodStart(height base.Height) base.Height {
+	if q.period < 1 {
+		return base.GenesisHeight
+	}
+
+	return height - base.Height(uint64(height)%q.period)
+}
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+// RevocationReason follows the reason codes of X.509 certificate revocation
+// lists(RFC 5280, 5.3.1). certificateHold and removeFromCRL are left out;
+// temporary holds are handled by suspend and reinstate.
+type RevocationReason string
+
+const (
+	RevocationReasonUnspecified          RevocationReason = "unspecified"
+	RevocationReasonKeyCompromise        RevocationReason = "keyCompromise"
+	RevocationReasonCACompromise         RevocationReason = "cACompromise"
+	RevocationReasonAffiliationChanged   RevocationReason = "affiliationChanged"
+	RevocationReasonSuperseded           RevocationReason = "superseded"
+	RevocationReasonCessationOfOperation RevocationReason = "cessationOfOperation"
+	RevocationReasonPrivilegeWithdrawn   RevocationReason = "privilegeWithdrawn"
+	RevocationReasonAACompromise         RevocationReason = "aACompromise"
+)
+
+func (r RevocationReason) Bytes() []byte {
+	return []byte(r)
+}
+
+func (r RevocationReason) String() string {
+	return string(r)
+}
+
+// IsValid allows empty reason; the reason code is optional.
+func (r RevocationReason) IsValid([]byte) error {
+	switch r {
+	case "",
+		RevocationReasonUnspecified,
+		RevocationReasonKeyCompromise,
+		RevocationReasonCACompromise,
+		RevocationReasonAffiliationChanged,
+		RevocationReasonSuperseded,
+		RevocationReasonCessationOfOperation,
+		RevocationReasonPrivilegeWithdrawn,
+		RevocationReasonAACompromise:
+		return nil
+	default:
+		return common.ErrValueInvalid.Errorf("unknown revocation reason, %v", r)
+	}
+}
+
+func IsValidRevocationNote(note string) error {
+	if l := utf8.RuneCountInString(note); l > MaxLengthRevocationNote {
+		return common.ErrValOOR.Errorf("length of revocation note <= %d", MaxLengthRevocationNote)
+	}
+
+	return nil
+}
+
+var RevocationHint = hint.MustNewHint("mitum-credential-revocation-v0.0.1")
+
+type Revocation struct {
+	hint.BaseHinter
+	reason       RevocationReason
+	note         string
+	revoker      base.Address
+	height       base.Height
+	cascadedFrom *CredentialRef
+}
+
+func NewRevocation(reason RevocationReason, note string, revoker base.Address, height base.Height) Revocation {
+	return Revocation{
+		BaseHinter: hint.NewBaseHinter(RevocationHint),
+		reason:     reason,
+		note:       note,
+		revoker:    revoker,
+		height:     height,
+	}
+}
+
+func (r Revocation) Bytes() []byte {
+	var cb []byte
+	if r.cascadedFrom != nil {
+		cb = r.cascadedFrom.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		r.reason.Bytes(),
+		[]byte(r.note),
+		r.revoker.Bytes(),
+		r.height.Bytes(),
+		cb,
+	)
+}
+
+func (r Revocation) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		r.BaseHinter,
+		r.reason,
+		r.revoker,
+		r.height,
+	); err != nil {
+		return err
+	}
+
+	if r.cascadedFrom != nil {
+		if err := r.cascadedFrom.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	return IsValidRevocationNote(r.note)
+}
+
+func (r Revocation) Reason() RevocationReason {
+	return r.reason
+}
+
+func (r Revocation) Note() string {
+	return r.note
+}
+
+func (r Revocation) Revoker() base.Address {
+	return r.revoker
+}
+
+func (r Revocation) Height() base.Height {
+	return r.height
+}
+
+// CascadedFrom returns the prerequisite credential of which revocation revoked
+// the credential together; nil if the credential was revoked by itself.
+func (r Revocation) CascadedFrom() *CredentialRef {
+	return r.cascadedFrom
+}
+
+func (r Revocation) SetCascadedFrom(ref CredentialRef) Revocation {
+	r.cascadedFrom = &ref
+
+	return r
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (r Revocation) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":   r.Hint().String(),
+		"reason":  r.reason,
+		"note":    r.note,
+		"revoker": r.revoker,
+		"height":  r.height,
+	}
+
+	if r.cascadedFrom != nil {
+		m["cascaded_from"] = r.cascadedFrom
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type RevocationBSONUnmarshaler struct {
+	Hint         string      `bson:"_hint"`
+	Reason       string      `bson:"reason"`
+	Note         string      `bson:"note"`
+	Revoker      string      `bson:"revoker"`
+	Height       base.Height `bson:"height"`
+	CascadedFrom bson.Raw    `bson:"cascaded_from,omitempty"`
+}
+
+func (r *Revocation) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of Revocation")
+
+	var u RevocationBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(u.CascadedFrom) > 0 {
+		var ref CredentialRef
+		if err := ref.DecodeBSON(u.CascadedFrom, enc); err != nil {
+			return e.Wrap(err)
+		}
+
+		r.cascadedFrom = &ref
+	}
+
+	return r.unpack(enc, ht, u.Reason, u.Note, u.Revoker, u.Height)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (r *Revocation) unpack(
+	enc encoder.Encoder, ht hint.Hint, reason, note, revoker string, height base.Height,
+) error {
+	e := util.StringError("unpack Revocation")
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.reason = RevocationReason(reason)
+	r.note = note
+	r.height = height
+
+	switch a, err := base.DecodeAddress(revoker, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		r.revoker = a
+	}
+
+	if err := r.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
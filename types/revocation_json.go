@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type RevocationJSONMarshaler struct {
+	hint.BaseHinter
+	Reason       RevocationReason `json:"reason"`
+	Note         string           `json:"note"`
+	Revoker      base.Address     `json:"revoker"`
+	Height       base.Height      `json:"height"`
+	CascadedFrom *CredentialRef   `json:"cascaded_from,omitempty"`
+}
+
+func (r Revocation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevocationJSONMarshaler{
+		BaseHinter:   r.BaseHinter,
+		Reason:       r.reason,
+		Note:         r.note,
+		Revoker:      r.revoker,
+		Height:       r.height,
+		CascadedFrom: r.cascadedFrom,
+	})
+}
+
+type RevocationJSONUnmarshaler struct {
+	Hint         hint.Hint       `json:"_hint"`
+	Reason       string          `json:"reason"`
+	Note         string          `json:"note"`
+	Revoker      string          `json:"revoker"`
+	Height       base.Height     `json:"height"`
+	CascadedFrom json.RawMessage `json:"cascaded_from"`
+}
+
+func (r *Revocation) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("decode json of Revocation")
+
+	var u RevocationJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(u.CascadedFrom) > 0 && string(u.CascadedFrom) != "null" {
+		var ref CredentialRef
+		if err := ref.DecodeJSON(u.CascadedFrom, enc); err != nil {
+			return e.Wrap(err)
+		}
+
+		r.cascadedFrom = &ref
+	}
+
+	return r.unpack(enc, u.Hint, u.Reason, u.Note, u.Revoker, u.Height)
+}
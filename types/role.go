@@ -0,0 +1,119 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+// Role is the permission of an account on a template of the credential
+// service.
+type Role string
+
+const (
+	// RoleTemplateAdmin adds, updates, deprecates and shares the template.
+	RoleTemplateAdmin Role = "template-admin"
+	// RoleIssuer issues the credentials of the template.
+	RoleIssuer Role = "issuer"
+	// RoleRevoker revokes, suspends and reinstates the credentials of the
+	// template.
+	RoleRevoker Role = "revoker"
+)
+
+var MaxTemplateRoleAssignments = 50
+
+func (r Role) Bytes() []byte {
+	return []byte(r)
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+func (r Role) IsValid([]byte) error {
+	switch r {
+	case RoleTemplateAdmin, RoleIssuer, RoleRevoker:
+		return nil
+	default:
+		return common.ErrValueInvalid.Errorf("unknown role, %v", r)
+	}
+}
+
+// IsValidRoles checks that roles are not empty, known and not duplicated.
+func IsValidRoles(roles []Role) error {
+	if len(roles) < 1 {
+		return common.ErrArrayLen.Errorf("empty roles")
+	}
+
+	founds := map[Role]struct{}{}
+	for i := range roles {
+		if err := roles[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[roles[i]]; found {
+			return common.ErrDupVal.Errorf("role %v", roles[i])
+		}
+
+		founds[roles[i]] = struct{}{}
+	}
+
+	return nil
+}
+
+var RoleAssignmentHint = hint.MustNewHint("mitum-credential-role-assignment-v0.0.1")
+
+// RoleAssignment is the roles of an account on a template.
+type RoleAssignment struct {
+	hint.BaseHinter
+	account base.Address
+	roles   []Role
+}
+
+func NewRoleAssignment(account base.Address, roles []Role) RoleAssignment {
+	return RoleAssignment{
+		BaseHinter: hint.NewBaseHinter(RoleAssignmentHint),
+		account:    account,
+		roles:      roles,
+	}
+}
+
+func (ra RoleAssignment) Bytes() []byte {
+	bs := make([][]byte, len(ra.roles)+1)
+	bs[0] = ra.account.Bytes()
+	for i := range ra.roles {
+		bs[i+1] = ra.roles[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (ra RoleAssignment) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		ra.BaseHinter,
+		ra.account,
+	); err != nil {
+		return err
+	}
+
+	return IsValidRoles(ra.roles)
+}
+
+func (ra RoleAssignment) Account() base.Address {
+	return ra.account
+}
+
+func (ra RoleAssignment) Roles() []Role {
+	return ra.roles
+}
+
+func (ra RoleAssignment) HasRole(role Role) bool {
+	for i := range ra.roles {
+		if ra.roles[i] == role {
+			return true
+		}
+	}
+
+	return false
+}
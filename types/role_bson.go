@@ -0,0 +1,41 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (ra RoleAssignment) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   ra.Hint().String(),
+			"account": ra.account,
+			"roles":   ra.roles,
+		},
+	)
+}
+
+type RoleAssignmentBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Account string   `bson:"account"`
+	Roles   []string `bson:"roles"`
+}
+
+func (ra *RoleAssignment) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("decode bson of RoleAssignment")
+
+	var u RoleAssignmentBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return ra.unpack(enc, ht, u.Account, u.Roles)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (ra *RoleAssignment) unpack(enc encoder.Encoder, ht hint.Hint, account string, roles []string) error {
+	e := util.StringError("unpack RoleAssignment")
+
+	ra.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(account, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		ra.account = a
+	}
+
+	ra.roles = make([]Role, len(roles))
+	for i := range roles {
+		ra.roles[i] = Role(roles[i])
+	}
+
+	if err := ra.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type RoleAssignmentJSONMarshaler struct {
+	hint.BaseHinter
+	Account base.Address `json:"account"`
+	Roles   []Role       `json:"roles"`
+}
+
+func (ra RoleAssignment) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RoleAssignmentJSONMarshaler{
+		BaseHinter: ra.BaseHinter,
+		Account:    ra.account,
+		Roles:      ra.roles,
+	})
+}
+
+type RoleAssignmentJSONUnmarshaler struct {
+	Hint    hint.Hint `json:"_hint"`
+	Account string    `json:"account"`
+	Roles   []string  `json:"roles"`
+}
+
+func (ra *RoleAssignment) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("decode json of RoleAssignment")
+
+	var u RoleAssignmentJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return ra.unpack(enc, u.Hint, u.Account, u.Roles)
+}
@@ -0,0 +1,544 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/pkg/errors"
+)
+
+var (
+	MaxLengthSchema   = 4096
+	MaxDepthSchema    = 8
+	ReValidSchemaHash = regexp.MustCompile(`^[0-9a-f]{64}$`)
+	// MaxLengthSchemaNumber and MaxExponentSchemaNumber bound the cost of
+	// parsing and comparing the numbers of schema and credential value.
+	MaxLengthSchemaNumber   = 64
+	MaxExponentSchemaNumber = 308
+)
+
+// Template schema is a subset of JSON Schema. Only the keywords below are
+// allowed, so the credential value is validated in the same way by every
+// node; the keywords which depend on regular expression dialects or remote
+// references, like pattern and $ref, are not supported.
+var (
+	schemaAnnotationKeywords = map[string]struct{}{
+		"$schema": {}, "$id": {}, "$comment": {}, "title": {}, "description": {}, "examples": {}, "default": {},
+	}
+	schemaValidationKeywords = map[string]struct{}{
+		"type": {}, "enum": {}, "const": {},
+		"properties": {}, "required": {}, "additionalProperties": {},
+		"items": {}, "minItems": {}, "maxItems": {},
+		"minLength": {}, "maxLength": {},
+		"minimum": {}, "maximum": {}, "exclusiveMinimum": {}, "exclusiveMaximum": {},
+	}
+	schemaTypes = map[string]struct{}{
+		"object": {}, "array": {}, "string": {}, "number": {}, "integer": {}, "boolean": {}, "null": {},
+	}
+)
+
+// SchemaHash returns the hex encoded sha256 of schema.
+func SchemaHash(schema string) string {
+	h := sha256.Sum256([]byte(schema))
+
+	return hex.EncodeToString(h[:])
+}
+
+// IsValidTemplateSchema checks the schema and schemaHash of template. Both are
+// optional; schemaHash alone refers the schema kept outside of the chain and
+// when both are given, schemaHash should be the hash of schema.
+func IsValidTemplateSchema(schema, schemaHash string) error {
+	if len(schema) > 0 {
+		if err := IsValidSchema(schema); err != nil {
+			return err
+		}
+	}
+
+	if len(schemaHash) < 1 {
+		return nil
+	}
+
+	if !ReValidSchemaHash.Match([]byte(schemaHash)) {
+		return common.ErrValueInvalid.Errorf("schema hash must be hex encoded sha256, %q", schemaHash)
+	}
+
+	if len(schema) > 0 && SchemaHash(schema) != schemaHash {
+		return common.ErrValueInvalid.Errorf("schema hash does not match with schema, %q", schemaHash)
+	}
+
+	return nil
+}
+
+// IsValidSchema checks that schema is a JSON Schema object composed of the
+// supported keywords.
+func IsValidSchema(schema string) error {
+	if l := utf8.RuneCountInString(schema); l > MaxLengthSchema {
+		return common.ErrValOOR.Errorf("length of schema <= %d, but %d", MaxLengthSchema, l)
+	}
+
+	v, err := decodeSchemaJSON(schema)
+	if err != nil {
+		return common.ErrValueInvalid.Wrap(errors.Wrap(err, "schema"))
+	}
+
+	if err := checkSchemaNode(v, "#", 0); err != nil {
+		return common.ErrValueInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+// ValidateBySchema validates the JSON document, value against schema. schema
+// should be checked by IsValidSchema beforehand; the schema of template is
+// checked once when the template is added, not on every issuance.
+func ValidateBySchema(schema, value string) error {
+	s, err := decodeSchemaJSON(schema)
+	if err != nil {
+		return common.ErrValueInvalid.Wrap(errors.Wrap(err, "schema"))
+	}
+
+	v, err := decodeSchemaJSON(value)
+	if err != nil {
+		return common.ErrValueInvalid.Wrap(errors.Wrap(err, "credential value is not JSON"))
+	}
+
+	if err := validateSchemaNode(s, v, "#"); err != nil {
+		return common.ErrValueInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func decodeSchemaJSON(s string) (interface{}, error) {
+	d := json.NewDecoder(bytes.NewReader([]byte(s)))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	if _, err := d.Token(); err != io.EOF {
+		return nil, errors.Errorf("trailing data")
+	}
+
+	return v, nil
+}
+
+func checkSchemaNode(node interface{}, path string, depth int) error {
+	if depth > MaxDepthSchema {
+		return errors.Errorf("%s: schema depth over %d", path, MaxDepthSchema)
+	}
+
+	m, ok := node.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("%s: schema must be object", path)
+	}
+
+	for _, k := range sortedKeys(m) {
+		if _, found := schemaAnnotationKeywords[k]; found {
+			continue
+		}
+
+		if _, found := schemaValidationKeywords[k]; !found {
+			return errors.Errorf("%s: unsupported keyword, %q", path, k)
+		}
+
+		v := m[k]
+		p := path + "/" + k
+
+		switch k {
+		case "type":
+			if err := checkSchemaType(v, p); err != nil {
+				return err
+			}
+		case "enum":
+			if a, ok := v.([]interface{}); !ok || len(a) < 1 {
+				return errors.Errorf("%s: must be non-empty array", p)
+			}
+		case "properties":
+			ps, ok := v.(map[string]interface{})
+			if !ok {
+				return errors.Errorf("%s: must be object", p)
+			}
+
+			for _, name := range sortedKeys(ps) {
+				if err := checkSchemaNode(ps[name], p+"/"+name, depth+1); err != nil {
+					return err
+				}
+			}
+		case "required":
+			a, ok := v.([]interface{})
+			if !ok {
+				return errors.Errorf("%s: must be array", p)
+			}
+
+			for i := range a {
+				if _, ok := a[i].(string); !ok {
+					return errors.Errorf("%s: must be array of string", p)
+				}
+			}
+		case "additionalProperties":
+			if _, ok := v.(bool); ok {
+				continue
+			}
+
+			if err := checkSchemaNode(v, p, depth+1); err != nil {
+				return err
+			}
+		case "items":
+			if err := checkSchemaNode(v, p, depth+1); err != nil {
+				return err
+			}
+		case "minItems", "maxItems", "minLength", "maxLength":
+			n, ok := v.(json.Number)
+			if !ok {
+				return errors.Errorf("%s: must be non-negative integer", p)
+			}
+
+			if i, err := n.Int64(); err != nil || i < 0 {
+				return errors.Errorf("%s: must be non-negative integer", p)
+			}
+		case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum":
+			if _, err := schemaNumber(v); err != nil {
+				return errors.Errorf("%s: must be number", p)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkSchemaType(v interface{}, path string) error {
+	var ts []interface{}
+
+	switch t := v.(type) {
+	case string:
+		ts = []interface{}{t}
+	case []interface{}:
+		ts = t
+	default:
+		return errors.Errorf("%s: must be string or array of string", path)
+	}
+
+	if len(ts) < 1 {
+		return errors.Errorf("%s: empty type", path)
+	}
+
+	for i := range ts {
+		s, ok := ts[i].(string)
+		if !ok {
+			return errors.Errorf("%s: must be string or array of string", path)
+		}
+
+		if _, found := schemaTypes[s]; !found {
+			return errors.Errorf("%s: unknown type, %q", path, s)
+		}
+	}
+
+	return nil
+}
+
+func validateSchemaNode(node, v interface{}, path string) error { //nolint:gocyclo
+	m, ok := node.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("%s: schema must be object", path)
+	}
+
+	if t, found := m["type"]; found {
+		if err := validateSchemaType(t, v, path); err != nil {
+			return err
+		}
+	}
+
+	if c, found := m["const"]; found && !schemaEqual(c, v) {
+		return errors.Errorf("%s: not equal to const", path)
+	}
+
+	if e, found := m["enum"]; found {
+		var matched bool
+		for _, i := range e.([]interface{}) {
+			if schemaEqual(i, v) {
+				matched = true
+
+				break
+			}
+		}
+
+		if !matched {
+			return errors.Errorf("%s: not in enum", path)
+		}
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		return validateSchemaObject(m, t, path)
+	case []interface{}:
+		if n, found := m["minItems"]; found && int64(len(t)) < schemaInt(n) {
+			return errors.Errorf("%s: items < minItems, %v", path, n)
+		}
+
+		if n, found := m["maxItems"]; found && int64(len(t)) > schemaInt(n) {
+			return errors.Errorf("%s: items > maxItems, %v", path, n)
+		}
+
+		if items, found := m["items"]; found {
+			for i := range t {
+				if err := validateSchemaNode(items, t[i], fmt.Sprintf("%s/%d", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case string:
+		l := int64(utf8.RuneCountInString(t))
+
+		if n, found := m["minLength"]; found && l < schemaInt(n) {
+			return errors.Errorf("%s: length < minLength, %v", path, n)
+		}
+
+		if n, found := m["maxLength"]; found && l > schemaInt(n) {
+			return errors.Errorf("%s: length > maxLength, %v", path, n)
+		}
+	case json.Number:
+		return validateSchemaNumber(m, t, path)
+	}
+
+	return nil
+}
+
+func validateSchemaObject(m map[string]interface{}, o map[string]interface{}, path string) error {
+	if r, found := m["required"]; found {
+		for _, i := range r.([]interface{}) {
+			if _, found := o[i.(string)]; !found {
+				return errors.Errorf("%s: missing required property, %q", path, i)
+			}
+		}
+	}
+
+	var properties map[string]interface{}
+	if ps, found := m["properties"]; found {
+		properties = ps.(map[string]interface{})
+	}
+
+	additional, hasAdditional := m["additionalProperties"]
+
+	for _, k := range sortedKeys(o) {
+		p := path + "/" + k
+
+		if s, found := properties[k]; found {
+			if err := validateSchemaNode(s, o[k], p); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if !hasAdditional {
+			continue
+		}
+
+		switch a := additional.(type) {
+		case bool:
+			if !a {
+				return errors.Errorf("%s: additional property not allowed", p)
+			}
+		default:
+			if err := validateSchemaNode(a, o[k], p); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateSchemaNumber(m map[string]interface{}, n json.Number, path string) error {
+	r, err := schemaNumber(n)
+	if err != nil {
+		return errors.Errorf("%s: invalid number", path)
+	}
+
+	for _, k := range []string{"minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum"} {
+		b, found := m[k]
+		if !found {
+			continue
+		}
+
+		l, err := schemaNumber(b)
+		if err != nil {
+			return errors.Errorf("%s: invalid %s, %v", path, k, b)
+		}
+
+		c := r.Cmp(l)
+
+		var failed bool
+
+		switch k {
+		case "minimum":
+			failed = c < 0
+		case "maximum":
+			failed = c > 0
+		case "exclusiveMinimum":
+			failed = c <= 0
+		case "exclusiveMaximum":
+			failed = c >= 0
+		}
+
+		if failed {
+			return errors.Errorf("%s: out of %s, %v", path, k, b)
+		}
+	}
+
+	return nil
+}
+
+func validateSchemaType(t, v interface{}, path string) error {
+	var ts []interface{}
+
+	switch i := t.(type) {
+	case string:
+		ts = []interface{}{i}
+	case []interface{}:
+		ts = i
+	}
+
+	for _, i := range ts {
+		if schemaTypeOf(i.(string), v) {
+			return nil
+		}
+	}
+
+	return errors.Errorf("%s: type mismatch, expected %v", path, t)
+}
+
+func schemaTypeOf(t string, v interface{}) bool {
+	switch i := v.(type) {
+	case nil:
+		return t == "null"
+	case bool:
+		return t == "boolean"
+	case string:
+		return t == "string"
+	case []interface{}:
+		return t == "array"
+	case map[string]interface{}:
+		return t == "object"
+	case json.Number:
+		switch t {
+		case "number":
+			return true
+		case "integer":
+			r, err := schemaNumber(i)
+
+			return err == nil && r.IsInt()
+		}
+	}
+
+	return false
+}
+
+// schemaNumber parses the JSON number, v. The numbers longer than
+// MaxLengthSchemaNumber or with the exponent out of MaxExponentSchemaNumber are
+// rejected before parsing.
+func schemaNumber(v interface{}) (*big.Rat, error) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return nil, errors.Errorf("not number")
+	}
+
+	s := n.String()
+	if len(s) > MaxLengthSchemaNumber {
+		return nil, errors.Errorf("length of number over %d, %d", MaxLengthSchemaNumber, len(s))
+	}
+
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		if e, err := strconv.Atoi(s[i+1:]); err != nil || e < -MaxExponentSchemaNumber || e > MaxExponentSchemaNumber {
+			return nil, errors.Errorf("exponent of number out of range, %q", s)
+		}
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, errors.Errorf("invalid number, %q", n)
+	}
+
+	return r, nil
+}
+
+func schemaInt(v interface{}) int64 {
+	i, _ := v.(json.Number).Int64()
+
+	return i
+}
+
+func schemaEqual(a, b interface{}) bool {
+	switch i := a.(type) {
+	case json.Number:
+		j, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+
+		x, err := schemaNumber(i)
+		if err != nil {
+			return false
+		}
+
+		y, err := schemaNumber(j)
+		if err != nil {
+			return false
+		}
+
+		return x.Cmp(y) == 0
+	case []interface{}:
+		j, ok := b.([]interface{})
+		if !ok || len(i) != len(j) {
+			return false
+		}
+
+		for k := range i {
+			if !schemaEqual(i[k], j[k]) {
+				return false
+			}
+		}
+
+		return true
+	case map[string]interface{}:
+		j, ok := b.(map[string]interface{})
+		if !ok || len(i) != len(j) {
+			return false
+		}
+
+		for k := range i {
+			if _, found := j[k]; !found || !schemaEqual(i[k], j[k]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return a == b
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+
+	sort.Strings(ks)
+
+	return ks
+}
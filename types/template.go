@@ -20,20 +20,40 @@ var (
 	MaxLengthSubjectKey      = 256
 	MaxLengthCredentialValue = 1024
 	MaxLengthDescription     = 1024
+	MaxLengthRevocationNote  = 1024
+	MaxLengthRequestID       = 20
+	MaxLengthRejectReason    = 1024
+	MaxTemplateAuditors      = 10
+	MaxTemplateGrantees      = 20
+	MaxTemplatePrerequisites = 10
 )
 
 type Template struct {
 	hint.BaseHinter
-	templateID     string
-	templateName   string
-	serviceDate    Date
-	expirationDate Date
-	templateShare  Bool
-	multiAudit     Bool
-	displayName    string
-	subjectKey     string
-	description    string
-	creator        base.Address
+	templateID       string
+	templateName     string
+	serviceDate      Date
+	expirationDate   Date
+	templateShare    Bool
+	multiAudit       Bool
+	displayName      string
+	subjectKey       string
+	description      string
+	creator          base.Address
+	schema           string
+	schemaHash       string
+	auditors         []base.Address
+	auditThreshold   uint64
+	grantees         []base.Address
+	offerLifetime    uint64
+	prerequisites    []string
+	cascade          Bool
+	maxPerHolder     uint64
+	uniqueValue      Bool
+	quota            Quota
+	serviceHeight    base.Height
+	expirationHeight base.Height
+	deprecated       Bool
 }
 
 func NewTemplate(
@@ -47,6 +67,10 @@ func NewTemplate(
 	subjectKey,
 	description string,
 	creator base.Address,
+	schema,
+	schemaHash string,
+	auditors []base.Address,
+	auditThreshold uint64,
 ) Template {
 	return Template{
 		BaseHinter:     hint.NewBaseHinter(TemplateHint),
@@ -60,6 +84,10 @@ func NewTemplate(
 		subjectKey:     subjectKey,
 		description:    description,
 		creator:        creator,
+		schema:         schema,
+		schemaHash:     schemaHash,
+		auditors:       auditors,
+		auditThreshold: auditThreshold,
 	}
 }
 
@@ -107,10 +135,86 @@ func (t Template) IsValid([]byte) error {
 		return common.ErrValOOR.Errorf("expire date <= service date, but %s <= %s", t.expirationDate, t.serviceDate)
 	}
 
+	if err := IsValidTemplateSchema(t.schema, t.schemaHash); err != nil {
+		return err
+	}
+
+	if err := IsValidTemplateAuditors(t.multiAudit, t.auditors, t.auditThreshold); err != nil {
+		return err
+	}
+
+	if err := IsValidTemplateGrantees(t.templateShare, t.grantees); err != nil {
+		return err
+	}
+
+	if err := IsValidTemplatePrerequisites(t.templateID, t.prerequisites, t.cascade); err != nil {
+		return err
+	}
+
+	if err := t.quota.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := IsValidTemplateServiceHeights(t.serviceHeight, t.expirationHeight); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (t Template) Bytes() []byte {
+	var ab []byte
+	if len(t.auditors) > 0 {
+		bs := make([][]byte, len(t.auditors)+1)
+		for i := range t.auditors {
+			bs[i] = t.auditors[i].Bytes()
+		}
+		bs[len(t.auditors)] = util.Uint64ToBytes(t.auditThreshold)
+
+		ab = util.ConcatBytesSlice(bs...)
+	}
+
+	var gb []byte
+	if len(t.grantees) > 0 {
+		bs := make([][]byte, len(t.grantees))
+		for i := range t.grantees {
+			bs[i] = t.grantees[i].Bytes()
+		}
+
+		gb = util.ConcatBytesSlice(bs...)
+	}
+
+	var ob []byte
+	if t.offerLifetime > 0 {
+		ob = util.Uint64ToBytes(t.offerLifetime)
+	}
+
+	var pb []byte
+	if len(t.prerequisites) > 0 {
+		bs := make([][]byte, len(t.prerequisites)+1)
+		for i := range t.prerequisites {
+			bs[i] = []byte(t.prerequisites[i])
+		}
+		bs[len(t.prerequisites)] = t.cascade.Bytes()
+
+		pb = util.ConcatBytesSlice(bs...)
+	}
+
+	var lb []byte
+	if t.maxPerHolder > 0 || t.uniqueValue {
+		lb = util.ConcatBytesSlice(util.Uint64ToBytes(t.maxPerHolder), t.uniqueValue.Bytes())
+	}
+
+	var hb []byte
+	if t.serviceHeight > 0 || t.expirationHeight > 0 {
+		hb = util.ConcatBytesSlice(t.serviceHeight.Bytes(), t.expirationHeight.Bytes())
+	}
+
+	var db []byte
+	if t.deprecated {
+		db = t.deprecated.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		[]byte(t.templateID),
 		[]byte(t.templateName),
@@ -122,6 +226,16 @@ func (t Template) Bytes() []byte {
 		[]byte(t.subjectKey),
 		[]byte(t.description),
 		t.creator.Bytes(),
+		[]byte(t.schema),
+		[]byte(t.schemaHash),
+		ab,
+		gb,
+		ob,
+		pb,
+		lb,
+		t.quota.Bytes(),
+		hb,
+		db,
 	)
 }
 
@@ -164,3 +278,374 @@ func (t Template) Description() string {
 func (t Template) Creator() base.Address {
 	return t.creator
 }
+
+// Schema returns the inline JSON Schema of credential value. Empty schema means
+// the credential value is not validated.
+func (t Template) Schema() string {
+	return t.schema
+}
+
+func (t Template) SchemaHash() string {
+	return t.schemaHash
+}
+
+// ValidateValue validates the credential value by the inline schema.
+func (t Template) ValidateValue(value string) error {
+	if len(t.schema) < 1 {
+		return nil
+	}
+
+	return ValidateBySchema(t.schema, value)
+}
+
+// Auditors returns the addresses which approve the credentials of the
+// template before they become active.
+func (t Template) Auditors() []base.Address {
+	return t.auditors
+}
+
+func (t Template) AuditThreshold() uint64 {
+	return t.auditThreshold
+}
+
+// AuditRequired reports whether the credentials issued by the template wait
+// for the approvals of auditors. The templates added with multiAudit before
+// auditors were introduced have no auditors and issue active credentials.
+func (t Template) AuditRequired() bool {
+	return bool(t.multiAudit) && len(t.auditors) > 0
+}
+
+func (t Template) IsAuditor(address base.Address) bool {
+	for i := range t.auditors {
+		if t.auditors[i].Equal(address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Grantees returns the contract accounts which are allowed to issue
+// credentials under the shared template.
+func (t Template) Grantees() []base.Address {
+	return t.grantees
+}
+
+func (t Template) IsGrantee(contract base.Address) bool {
+	for i := range t.grantees {
+		if t.grantees[i].Equal(contract) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t Template) Deprecated() Bool {
+	return t.deprecated
+}
+
+// Update returns a copy of the template with its mutable fields replaced.
+// templateID, serviceDate, serviceHeight, subjectKey and creator are fixed once
+// the template is added.
+func (t Template) Update(
+	templateName string, expirationDate Date, expirationHeight base.Height, displayName, description string,
+) Template {
+	t.templateName = templateName
+	t.expirationDate = expirationDate
+	t.expirationHeight = expirationHeight
+	t.displayName = displayName
+	t.description = description
+
+	return t
+}
+
+func (t Template) SetDeprecated(deprecated Bool) Template {
+	t.deprecated = deprecated
+
+	return t
+}
+
+func (t Template) SetGrantees(grantees []base.Address) Template {
+	t.grantees = grantees
+
+	return t
+}
+
+// OfferLifetime returns the number of blocks in which the holder can accept
+// the offer of credential; 0 means the template does not require the consent
+// of holder.
+func (t Template) OfferLifetime() uint64 {
+	return t.offerLifetime
+}
+
+// ConsentRequired reports whether the credentials of the template are issued
+// only by OfferCredential and AcceptCredential.
+func (t Template) ConsentRequired() bool {
+	return t.offerLifetime > 0
+}
+
+func (t Template) SetOfferLifetime(lifetime uint64) Template {
+	t.offerLifetime = lifetime
+
+	return t
+}
+
+// Prerequisites returns the template ids of the credential service of which
+// the holder should have an active credential before the credential of the
+// template is issued or renewed; the validity period of the prerequisite
+// credential, in unix seconds, should cover the one of the credential.
+func (t Template) Prerequisites() []string {
+	return t.prerequisites
+}
+
+func (t Template) IsPrerequisite(templateID string) bool {
+	for i := range t.prerequisites {
+		if t.prerequisites[i] == templateID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Cascade reports whether the credentials of the template are revoked together
+// when the prerequisite credential of the holder is revoked.
+func (t Template) Cascade() Bool {
+	return t.cascade
+}
+
+func (t Template) SetPrerequisites(prerequisites []string, cascade Bool) Template {
+	t.prerequisites = prerequisites
+	t.cascade = cascade
+
+	return t
+}
+
+// MaxPerHolder returns the maximum number of the credentials of the template,
+// which are not revoked nor renounced, held by a holder; 0 means unlimited.
+func (t Template) MaxPerHolder() uint64 {
+	return t.maxPerHolder
+}
+
+// UniqueValue reports whether the value of the credential of the template is
+// held by at most one holder among the credentials not revoked nor renounced.
+func (t Template) UniqueValue() Bool {
+	return t.uniqueValue
+}
+
+// Quota returns the issuance quota of the template, which limits the
+// credentials of the template issued by each credential service.
+func (t Template) Quota() Quota {
+	return t.quota
+}
+
+func (t Template) SetQuota(quota Quota) Template {
+	t.quota = quota
+
+	return t
+}
+
+func (t Template) SetIssuanceLimits(maxPerHolder uint64, uniqueValue Bool) Template {
+	t.maxPerHolder = maxPerHolder
+	t.uniqueValue = uniqueValue
+
+	return t
+}
+
+// ServicePeriod returns the service period of the template in unix seconds,
+// from the start of serviceDate to the end of expirationDate in UTC.
+func (t Template) ServicePeriod() (uint64, uint64, error) {
+	serviceDate, err := t.serviceDate.Parse()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	expirationDate, err := t.expirationDate.Parse()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return uint64(serviceDate.Unix()), uint64(expirationDate.AddDate(0, 0, 1).Unix()) - 1, nil
+}
+
+// CheckServicePeriod checks that the validity period of credential, validFrom
+// and validUntil in unix seconds, is inside of the service period.
+func (t Template) CheckServicePeriod(validFrom, validUntil uint64) error {
+	from, until, err := t.ServicePeriod()
+	if err != nil {
+		return common.ErrValueInvalid.Wrap(err)
+	}
+
+	if validFrom < from || validUntil > until {
+		return ErrOutOfServicePeriod.Errorf(
+			"valid period, %d ~ %d is not in service period of template %v, %s ~ %s",
+			validFrom, validUntil, t.templateID, t.serviceDate, t.expirationDate)
+	}
+
+	return nil
+}
+
+// ServiceHeight returns the block height from which the template issues
+// credentials; 0 means no lower bound.
+func (t Template) ServiceHeight() base.Height {
+	return t.serviceHeight
+}
+
+// ExpirationHeight returns the last block height in which the template issues
+// credentials; 0 means no upper bound.
+func (t Template) ExpirationHeight() base.Height {
+	return t.expirationHeight
+}
+
+func (t Template) SetServiceHeights(serviceHeight, expirationHeight base.Height) Template {
+	t.serviceHeight = serviceHeight
+	t.expirationHeight = expirationHeight
+
+	return t
+}
+
+// CheckServiceHeight checks that the template is in service at height.
+//
+// NOTE operation processors have no access to the proposal time, so the
+// service window of template in time is enforced on the validity period of
+// credential by CheckServicePeriod and the service window in block height is
+// enforced on the height of the proposal.
+func (t Template) CheckServiceHeight(height base.Height) error {
+	if t.serviceHeight > 0 && height < t.serviceHeight {
+		return ErrOutOfServicePeriod.Errorf(
+			"template %v is not in service until height %v, current height %v",
+			t.templateID, t.serviceHeight, height)
+	}
+
+	if t.expirationHeight > 0 && height > t.expirationHeight {
+		return ErrOutOfServicePeriod.Errorf(
+			"template %v expired at height %v, current height %v",
+			t.templateID, t.expirationHeight, height)
+	}
+
+	return nil
+}
+
+// IsValidTemplateServiceHeights checks the service window of template in block
+// height; 0 means the window is not bounded on that side.
+func IsValidTemplateServiceHeights(serviceHeight, expirationHeight base.Height) error {
+	if serviceHeight > 0 && expirationHeight > 0 && expirationHeight < serviceHeight {
+		return common.ErrValOOR.Errorf(
+			"expiration height < service height, %v < %v", expirationHeight, serviceHeight)
+	}
+
+	return nil
+}
+
+// IsValidTemplateAuditors checks the auditor set of template; auditors are
+// allowed only for multiAudit template and auditThreshold of them must
+// approve a credential.
+func IsValidTemplateAuditors(multiAudit Bool, auditors []base.Address, auditThreshold uint64) error {
+	if len(auditors) < 1 {
+		if auditThreshold != 0 {
+			return common.ErrValueInvalid.Errorf("audit threshold without auditors, %d", auditThreshold)
+		}
+
+		return nil
+	}
+
+	if !bool(multiAudit) {
+		return common.ErrValueInvalid.Errorf("auditors for template without multi audit")
+	}
+
+	if l := len(auditors); l > MaxTemplateAuditors {
+		return common.ErrArrayLen.Errorf("auditors, %d over max, %d", l, MaxTemplateAuditors)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range auditors {
+		if err := auditors[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[auditors[i].String()]; found {
+			return common.ErrDupVal.Errorf("auditor %v", auditors[i])
+		}
+
+		founds[auditors[i].String()] = struct{}{}
+	}
+
+	if auditThreshold < 1 || auditThreshold > uint64(len(auditors)) {
+		return common.ErrValOOR.Errorf("1 <= audit threshold <= %d, but %d", len(auditors), auditThreshold)
+	}
+
+	return nil
+}
+
+// IsValidTemplateGrantees checks the grant list of template; only the template
+// with templateShare can be granted to other credential services.
+func IsValidTemplateGrantees(templateShare Bool, grantees []base.Address) error {
+	if len(grantees) < 1 {
+		return nil
+	}
+
+	if !bool(templateShare) {
+		return common.ErrValueInvalid.Errorf("grantees for template without template share")
+	}
+
+	if l := len(grantees); l > MaxTemplateGrantees {
+		return common.ErrArrayLen.Errorf("grantees, %d over max, %d", l, MaxTemplateGrantees)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range grantees {
+		if err := grantees[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[grantees[i].String()]; found {
+			return common.ErrDupVal.Errorf("grantee %v", grantees[i])
+		}
+
+		founds[grantees[i].String()] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsValidTemplatePrerequisites checks the prerequisite template ids of the
+// template of templateID; cascade is allowed only with prerequisites.
+func IsValidTemplatePrerequisites(templateID string, prerequisites []string, cascade Bool) error {
+	if len(prerequisites) < 1 {
+		if cascade {
+			return common.ErrValueInvalid.Errorf("cascade revocation without prerequisites")
+		}
+
+		return nil
+	}
+
+	if l := len(prerequisites); l > MaxTemplatePrerequisites {
+		return common.ErrArrayLen.Errorf("prerequisites, %d over max, %d", l, MaxTemplatePrerequisites)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range prerequisites {
+		p := prerequisites[i]
+
+		if l := utf8.RuneCountInString(p); l < 1 || l > MaxLengthTemplateID {
+			return common.ErrValOOR.Errorf("0 <= length of prerequisite template ID <= %d", MaxLengthTemplateID)
+		}
+
+		if !crcytypes.ReValidSpcecialCh.Match([]byte(p)) {
+			return common.ErrValueInvalid.Wrap(errors.Errorf("prerequisite template ID %s, must match regex `^[^\\s:/?#\\[\\]$@]*$`", p))
+		}
+
+		if p == templateID {
+			return common.ErrSelfTarget.Errorf("template %v is prerequisite of itself", p)
+		}
+
+		if _, found := founds[p]; found {
+			return common.ErrDupVal.Errorf("duplicated prerequisite, %v", p)
+		}
+
+		founds[p] = struct{}{}
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
@@ -11,33 +12,65 @@ import (
 func (t Template) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":           t.Hint().String(),
-			"template_id":     t.templateID,
-			"template_name":   t.templateName,
-			"service_date":    t.serviceDate,
-			"expiration_date": t.expirationDate,
-			"template_share":  t.templateShare,
-			"multi_audit":     t.multiAudit,
-			"display_name":    t.displayName,
-			"subject_key":     t.subjectKey,
-			"description":     t.description,
-			"creator":         t.creator,
+			"_hint":             t.Hint().String(),
+			"template_id":       t.templateID,
+			"template_name":     t.templateName,
+			"service_date":      t.serviceDate,
+			"expiration_date":   t.expirationDate,
+			"template_share":    t.templateShare,
+			"multi_audit":       t.multiAudit,
+			"display_name":      t.displayName,
+			"subject_key":       t.subjectKey,
+			"description":       t.description,
+			"creator":           t.creator,
+			"schema":            t.schema,
+			"schema_hash":       t.schemaHash,
+			"auditors":          t.auditors,
+			"audit_threshold":   t.auditThreshold,
+			"grantees":          t.grantees,
+			"offer_lifetime":    t.offerLifetime,
+			"prerequisites":     t.prerequisites,
+			"cascade":           t.cascade,
+			"max_per_holder":    t.maxPerHolder,
+			"unique_value":      t.uniqueValue,
+			"max_credentials":   t.quota.MaxCredentials(),
+			"max_issuances":     t.quota.MaxIssuances(),
+			"issuance_period":   t.quota.Period(),
+			"service_height":    t.serviceHeight,
+			"expiration_height": t.expirationHeight,
+			"deprecated":        t.deprecated,
 		},
 	)
 }
 
 type TemplateBSONUnmarshaler struct {
-	Hint           string `bson:"_hint"`
-	TemplateID     string `bson:"template_id"`
-	TemplateName   string `bson:"template_name"`
-	ServiceDate    string `bson:"service_date"`
-	ExpirationDate string `bson:"expiration_date"`
-	TemplateShare  bool   `bson:"template_share"`
-	MultiAudit     bool   `bson:"multi_audit"`
-	DisplayName    string `bson:"display_name"`
-	SubjectKey     string `bson:"subject_key"`
-	Description    string `bson:"description"`
-	Creator        string `bson:"creator"`
+	Hint             string      `bson:"_hint"`
+	TemplateID       string      `bson:"template_id"`
+	TemplateName     string      `bson:"template_name"`
+	ServiceDate      string      `bson:"service_date"`
+	ExpirationDate   string      `bson:"expiration_date"`
+	TemplateShare    bool        `bson:"template_share"`
+	MultiAudit       bool        `bson:"multi_audit"`
+	DisplayName      string      `bson:"display_name"`
+	SubjectKey       string      `bson:"subject_key"`
+	Description      string      `bson:"description"`
+	Creator          string      `bson:"creator"`
+	Schema           string      `bson:"schema"`
+	SchemaHash       string      `bson:"schema_hash"`
+	Auditors         []string    `bson:"auditors"`
+	AuditThreshold   uint64      `bson:"audit_threshold"`
+	Grantees         []string    `bson:"grantees"`
+	OfferLifetime    uint64      `bson:"offer_lifetime"`
+	Prerequisites    []string    `bson:"prerequisites"`
+	Cascade          bool        `bson:"cascade"`
+	MaxPerHolder     uint64      `bson:"max_per_holder"`
+	UniqueValue      bool        `bson:"unique_value"`
+	MaxCredentials   uint64      `bson:"max_credentials"`
+	MaxIssuances     uint64      `bson:"max_issuances"`
+	IssuancePeriod   uint64      `bson:"issuance_period"`
+	ServiceHeight    base.Height `bson:"service_height"`
+	ExpirationHeight base.Height `bson:"expiration_height"`
+	Deprecated       bool        `bson:"deprecated"`
 }
 
 func (t *Template) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -64,5 +97,21 @@ func (t *Template) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		u.SubjectKey,
 		u.Description,
 		u.Creator,
+		u.Schema,
+		u.SchemaHash,
+		u.Auditors,
+		u.AuditThreshold,
+		u.Grantees,
+		u.OfferLifetime,
+		u.Prerequisites,
+		u.Cascade,
+		u.MaxPerHolder,
+		u.UniqueValue,
+		u.MaxCredentials,
+		u.MaxIssuances,
+		u.IssuancePeriod,
+		u.ServiceHeight,
+		u.ExpirationHeight,
+		u.Deprecated,
 	)
 }
@@ -12,6 +12,18 @@ func (t *Template) unpack(enc encoder.Encoder, ht hint.Hint,
 	tmplName, svcDate, expDate string,
 	share, audit bool,
 	dpName, subjKey, desc, creator string,
+	schema, schemaHash string,
+	auditors []string,
+	auditThreshold uint64,
+	grantees []string,
+	offerLifetime uint64,
+	prerequisites []string,
+	cascade bool,
+	maxPerHolder uint64,
+	uniqueValue bool,
+	maxCredentials, maxIssuances, issuancePeriod uint64,
+	serviceHeight, expirationHeight base.Height,
+	deprecated bool,
 ) error {
 	e := util.StringError("unpack Template")
 
@@ -25,6 +37,21 @@ func (t *Template) unpack(enc encoder.Encoder, ht hint.Hint,
 	t.displayName = dpName
 	t.subjectKey = subjKey
 	t.description = desc
+	t.schema = schema
+	t.schemaHash = schemaHash
+	t.auditThreshold = auditThreshold
+	t.offerLifetime = offerLifetime
+	t.cascade = Bool(cascade)
+	t.maxPerHolder = maxPerHolder
+	t.uniqueValue = Bool(uniqueValue)
+	t.quota = NewQuota(maxCredentials, maxIssuances, issuancePeriod)
+	t.serviceHeight = serviceHeight
+	t.expirationHeight = expirationHeight
+	t.deprecated = Bool(deprecated)
+
+	if len(prerequisites) > 0 {
+		t.prerequisites = prerequisites
+	}
 
 	switch a, err := base.DecodeAddress(creator, enc); {
 	case err != nil:
@@ -32,6 +59,29 @@ func (t *Template) unpack(enc encoder.Encoder, ht hint.Hint,
 	default:
 		t.creator = a
 	}
+
+	if len(auditors) > 0 {
+		t.auditors = make([]base.Address, len(auditors))
+		for i := range auditors {
+			a, err := base.DecodeAddress(auditors[i], enc)
+			if err != nil {
+				return e.Wrap(err)
+			}
+			t.auditors[i] = a
+		}
+	}
+
+	if len(grantees) > 0 {
+		t.grantees = make([]base.Address, len(grantees))
+		for i := range grantees {
+			a, err := base.DecodeAddress(grantees[i], enc)
+			if err != nil {
+				return e.Wrap(err)
+			}
+			t.grantees[i] = a
+		}
+	}
+
 	if err := t.IsValid(nil); err != nil {
 		return e.Wrap(err)
 	}
@@ -9,46 +9,94 @@ import (
 
 type TemplateJSONMarshaler struct {
 	hint.BaseHinter
-	TemplateID     string       `json:"template_id"`
-	TemplateName   string       `json:"template_name"`
-	ServiceDate    Date         `json:"service_date"`
-	ExpirationDate Date         `json:"expiration_date"`
-	TemplateShare  Bool         `json:"template_share"`
-	MultiAudit     Bool         `json:"multi_audit"`
-	DisplayName    string       `json:"display_name"`
-	SubjectKey     string       `json:"subject_key"`
-	Description    string       `json:"description"`
-	Creator        base.Address `json:"creator"`
+	TemplateID       string         `json:"template_id"`
+	TemplateName     string         `json:"template_name"`
+	ServiceDate      Date           `json:"service_date"`
+	ExpirationDate   Date           `json:"expiration_date"`
+	TemplateShare    Bool           `json:"template_share"`
+	MultiAudit       Bool           `json:"multi_audit"`
+	DisplayName      string         `json:"display_name"`
+	SubjectKey       string         `json:"subject_key"`
+	Description      string         `json:"description"`
+	Creator          base.Address   `json:"creator"`
+	Schema           string         `json:"schema,omitempty"`
+	SchemaHash       string         `json:"schema_hash,omitempty"`
+	Auditors         []base.Address `json:"auditors,omitempty"`
+	AuditThreshold   uint64         `json:"audit_threshold,omitempty"`
+	Grantees         []base.Address `json:"grantees,omitempty"`
+	OfferLifetime    uint64         `json:"offer_lifetime,omitempty"`
+	Prerequisites    []string       `json:"prerequisites,omitempty"`
+	Cascade          Bool           `json:"cascade,omitempty"`
+	MaxPerHolder     uint64         `json:"max_per_holder,omitempty"`
+	UniqueValue      Bool           `json:"unique_value,omitempty"`
+	MaxCredentials   uint64         `json:"max_credentials,omitempty"`
+	MaxIssuances     uint64         `json:"max_issuances,omitempty"`
+	IssuancePeriod   uint64         `json:"issuance_period,omitempty"`
+	ServiceHeight    base.Height    `json:"service_height,omitempty"`
+	ExpirationHeight base.Height    `json:"expiration_height,omitempty"`
+	Deprecated       Bool           `json:"deprecated"`
 }
 
 func (t Template) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(TemplateJSONMarshaler{
-		BaseHinter:     t.BaseHinter,
-		TemplateID:     t.templateID,
-		TemplateName:   t.templateName,
-		ServiceDate:    t.serviceDate,
-		ExpirationDate: t.expirationDate,
-		TemplateShare:  t.templateShare,
-		MultiAudit:     t.multiAudit,
-		DisplayName:    t.displayName,
-		SubjectKey:     t.subjectKey,
-		Description:    t.description,
-		Creator:        t.creator,
+		BaseHinter:       t.BaseHinter,
+		TemplateID:       t.templateID,
+		TemplateName:     t.templateName,
+		ServiceDate:      t.serviceDate,
+		ExpirationDate:   t.expirationDate,
+		TemplateShare:    t.templateShare,
+		MultiAudit:       t.multiAudit,
+		DisplayName:      t.displayName,
+		SubjectKey:       t.subjectKey,
+		Description:      t.description,
+		Creator:          t.creator,
+		Schema:           t.schema,
+		SchemaHash:       t.schemaHash,
+		Auditors:         t.auditors,
+		AuditThreshold:   t.auditThreshold,
+		Grantees:         t.grantees,
+		OfferLifetime:    t.offerLifetime,
+		Prerequisites:    t.prerequisites,
+		Cascade:          t.cascade,
+		MaxPerHolder:     t.maxPerHolder,
+		UniqueValue:      t.uniqueValue,
+		MaxCredentials:   t.quota.MaxCredentials(),
+		MaxIssuances:     t.quota.MaxIssuances(),
+		IssuancePeriod:   t.quota.Period(),
+		ServiceHeight:    t.serviceHeight,
+		ExpirationHeight: t.expirationHeight,
+		Deprecated:       t.deprecated,
 	})
 }
 
 type TemplateJSONUnmarshaler struct {
-	Hint           hint.Hint `json:"_hint"`
-	TemplateID     string    `json:"template_id"`
-	TemplateName   string    `json:"template_name"`
-	ServiceDate    string    `json:"service_date"`
-	ExpirationDate string    `json:"expiration_date"`
-	TemplateShare  bool      `json:"template_share"`
-	MultiAudit     bool      `json:"multi_audit"`
-	DisplayName    string    `json:"display_name"`
-	SubjectKey     string    `json:"subject_key"`
-	Description    string    `json:"description"`
-	Creator        string    `json:"creator"`
+	Hint             hint.Hint   `json:"_hint"`
+	TemplateID       string      `json:"template_id"`
+	TemplateName     string      `json:"template_name"`
+	ServiceDate      string      `json:"service_date"`
+	ExpirationDate   string      `json:"expiration_date"`
+	TemplateShare    bool        `json:"template_share"`
+	MultiAudit       bool        `json:"multi_audit"`
+	DisplayName      string      `json:"display_name"`
+	SubjectKey       string      `json:"subject_key"`
+	Description      string      `json:"description"`
+	Creator          string      `json:"creator"`
+	Schema           string      `json:"schema"`
+	SchemaHash       string      `json:"schema_hash"`
+	Auditors         []string    `json:"auditors"`
+	AuditThreshold   uint64      `json:"audit_threshold"`
+	Grantees         []string    `json:"grantees"`
+	OfferLifetime    uint64      `json:"offer_lifetime"`
+	Prerequisites    []string    `json:"prerequisites"`
+	Cascade          bool        `json:"cascade"`
+	MaxPerHolder     uint64      `json:"max_per_holder"`
+	UniqueValue      bool        `json:"unique_value"`
+	MaxCredentials   uint64      `json:"max_credentials"`
+	MaxIssuances     uint64      `json:"max_issuances"`
+	IssuancePeriod   uint64      `json:"issuance_period"`
+	ServiceHeight    base.Height `json:"service_height"`
+	ExpirationHeight base.Height `json:"expiration_height"`
+	Deprecated       bool        `json:"deprecated"`
 }
 
 func (t *Template) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -70,5 +118,21 @@ func (t *Template) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		u.SubjectKey,
 		u.Description,
 		u.Creator,
+		u.Schema,
+		u.SchemaHash,
+		u.Auditors,
+		u.AuditThreshold,
+		u.Grantees,
+		u.OfferLifetime,
+		u.Prerequisites,
+		u.Cascade,
+		u.MaxPerHolder,
+		u.UniqueValue,
+		u.MaxCredentials,
+		u.MaxIssuances,
+		u.IssuancePeriod,
+		u.ServiceHeight,
+		u.ExpirationHeight,
+		u.Deprecated,
 	)
 }
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+func TestTemplateLegacyBytes(t *testing.T) {
+	creator := currencytypes.NewAddress("0x0123456789abcDEF0123456789abCDef01234567")
+
+	tmpl := NewTemplate(
+		"t1", "template", "2024-01-01", "2099-12-31", true, false,
+		"display", "subject", "description", creator, "", "", nil, 0,
+	)
+
+	// NOTE the bytes of the templates stored before the new fields must not
+	// be changed, or the hash of the template states of the old blocks does
+	// not match.
+	legacy := util.ConcatBytesSlice(
+		[]byte("t1"),
+		[]byte("template"),
+		[]byte("2024-01-01"),
+		[]byte("2099-12-31"),
+		[]byte{1},
+		[]byte{0},
+		[]byte("display"),
+		[]byte("subject"),
+		[]byte("description"),
+		creator.Bytes(),
+	)
+
+	if b := tmpl.Bytes(); !bytes.Equal(b, legacy) {
+		t.Fatalf("legacy template bytes changed, %x != %x", b, legacy)
+	}
+
+	if b := tmpl.SetDeprecated(false).Bytes(); !bytes.Equal(b, legacy) {
+		t.Fatalf("legacy template bytes changed by not deprecated, %x != %x", b, legacy)
+	}
+
+	if b := tmpl.SetDeprecated(true).Bytes(); bytes.Equal(b, legacy) {
+		t.Fatal("deprecated template has the legacy bytes")
+	}
+}
@@ -41,3 +41,90 @@ func (b Bool) Bytes() []byte {
 	}
 	return []byte{0}
 }
+
+type CredentialStatus string
+
+const (
+	CredentialStatusPending   CredentialStatus = "pending"
+	CredentialStatusActive    CredentialStatus = "active"
+	CredentialStatusSuspended CredentialStatus = "suspended"
+	CredentialStatusRevoked   CredentialStatus = "revoked"
+	CredentialStatusRenounced CredentialStatus = "renounced"
+)
+
+func (s CredentialStatus) Bytes() []byte {
+	return []byte(s)
+}
+
+func (s CredentialStatus) String() string {
+	return string(s)
+}
+
+func (s CredentialStatus) IsValid([]byte) error {
+	switch s {
+	case CredentialStatusPending, CredentialStatusActive, CredentialStatusSuspended, CredentialStatusRevoked,
+		CredentialStatusRenounced:
+		return nil
+	default:
+		return common.ErrValueInvalid.Errorf("unknown credential status, %v", s)
+	}
+}
+
+// IsTerminated reports whether the credential is no longer valid for good;
+// revoked by the issuer or renounced by the holder.
+func (s CredentialStatus) IsTerminated() bool {
+	return s == CredentialStatusRevoked || s == CredentialStatusRenounced
+}
+
+type OfferStatus string
+
+const (
+	OfferStatusPending  OfferStatus = "pending"
+	OfferStatusAccepted OfferStatus = "accepted"
+	OfferStatusDeclined OfferStatus = "declined"
+	// OfferStatusExpired is not stored in the offer state; the pending offer
+	// is expired after its expiry height.
+	OfferStatusExpired OfferStatus = "expired"
+)
+
+func (s OfferStatus) Bytes() []byte {
+	return []byte(s)
+}
+
+func (s OfferStatus) String() string {
+	return string(s)
+}
+
+func (s OfferStatus) IsValid([]byte) error {
+	switch s {
+	case OfferStatusPending, OfferStatusAccepted, OfferStatusDeclined:
+		return nil
+	default:
+		return common.ErrValueInvalid.Errorf("unknown offer status, %v", s)
+	}
+}
+
+type RequestStatus string
+
+const (
+	RequestStatusPending  RequestStatus = "pending"
+	RequestStatusApproved RequestStatus = "approved"
+	RequestStatusRejected RequestStatus = "rejected"
+)
+
+func (s RequestStatus) Bytes() []byte {
+	return []byte(s)
+}
+
+func (s RequestStatus) String() string {
+	return string(s)
+}
+
+func (s RequestStatus) IsValid([]byte) error {
+	switch s {
+	case RequestStatusPending, RequestStatusApproved, RequestStatusRejected:
+		return nil
+	default:
+		return common.ErrValueInvalid.Errorf("unknown request status, %v", s)
+	}
+}